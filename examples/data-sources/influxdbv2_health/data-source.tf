@@ -0,0 +1,5 @@
+data "influxdbv2_health" "server" {}
+
+output "influxdb_version" {
+  value = data.influxdbv2_health.server.version
+}
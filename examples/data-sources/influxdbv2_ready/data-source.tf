@@ -0,0 +1,5 @@
+data "influxdbv2_ready" "server" {}
+
+output "influxdb_uptime" {
+  value = data.influxdbv2_ready.server.up
+}
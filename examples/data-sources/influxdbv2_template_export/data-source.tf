@@ -0,0 +1,10 @@
+data "influxdbv2_template_export" "snapshot" {
+  org_id         = "ORG_ID"
+  resource_kinds = ["Bucket", "Dashboard"]
+  label_names    = ["production"]
+}
+
+resource "local_file" "snapshot" {
+  filename = "${path.module}/snapshot.yml"
+  content  = data.influxdbv2_template_export.snapshot.yaml
+}
@@ -0,0 +1,34 @@
+resource "influxdbv2_bucket" "example_bucket" {
+  name   = "example_bucket_1"
+  org_id = "example_org_id"
+}
+
+# A write token that only exists for the duration of the run.
+ephemeral "influxdbv2_authorization" "seed" {
+  org_id      = influxdbv2_bucket.example_bucket.org_id
+  description = "seed points"
+  permissions {
+    action = "write"
+    resource {
+      id     = influxdbv2_bucket.example_bucket.id
+      org_id = influxdbv2_bucket.example_bucket.org_id
+      type   = "buckets"
+    }
+  }
+}
+
+provider "influxdbv2" {
+  alias = "seed"
+  host  = "http://localhost:8086"
+  token = ephemeral.influxdbv2_authorization.seed.token
+}
+
+resource "influxdbv2_points" "slo_targets" {
+  provider  = influxdbv2.seed
+  org_id    = influxdbv2_bucket.example_bucket.org_id
+  bucket_id = influxdbv2_bucket.example_bucket.id
+
+  line_protocol = <<-EOT
+    slo,service=api target=99.9
+  EOT
+}
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    influxdbv2 = {
+      source = "matrasas/influxdbv2"
+    }
+  }
+}
+
+resource "influxdbv2_bucket" "example_bucket" {
+  name   = "example_bucket_1"
+  org_id = "example_org_id"
+  retention_rules {
+    every_seconds                = provider::influxdbv2::duration_to_seconds("30d")
+    shard_group_duration_seconds = provider::influxdbv2::duration_to_seconds("1d")
+  }
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    influxdbv2 = {
+      source = "matrasas/influxdbv2"
+    }
+  }
+}
+
+variable "bucket_name" {
+  type    = string
+  default = "team \"a\" metrics"
+}
+
+output "query" {
+  # from(bucket: "team \"a\" metrics") |> range(start: -1h)
+  value = "from(bucket: ${provider::influxdbv2::flux_string(var.bucket_name)}) |> range(start: -1h)"
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    influxdbv2 = {
+      source = "matrasas/influxdbv2"
+    }
+  }
+}
+
+resource "influxdbv2_bucket" "example_bucket" {
+  name   = "example_bucket_1"
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_points" "site_metadata" {
+  org_id    = influxdbv2_bucket.example_bucket.org_id
+  bucket_id = influxdbv2_bucket.example_bucket.id
+
+  # site,site=vilnius active=true,capacity=42i,name="Vilnius DC"
+  line_protocol = provider::influxdbv2::line_protocol("site", { site = "vilnius" }, {
+    name     = "\"Vilnius DC\""
+    capacity = "42i"
+    active   = "true"
+  })
+}
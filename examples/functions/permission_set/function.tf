@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    influxdbv2 = {
+      source = "matrasas/influxdbv2"
+    }
+  }
+}
+
+resource "influxdbv2_bucket" "metrics" {
+  name   = "metrics"
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_bucket" "events" {
+  name   = "events"
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_authorization" "writer" {
+  org_id      = "example_org_id"
+  description = "writes metrics and events"
+
+  dynamic "permissions" {
+    for_each = provider::influxdbv2::permission_set([influxdbv2_bucket.metrics.id, influxdbv2_bucket.events.id], "write")
+    content {
+      action = permissions.value.action
+      dynamic "resource" {
+        for_each = permissions.value.resource
+        content {
+          type   = resource.value.type
+          id     = resource.value.id
+          org_id = resource.value.org_id
+        }
+      }
+    }
+  }
+}
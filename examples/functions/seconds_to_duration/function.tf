@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    influxdbv2 = {
+      source = "matrasas/influxdbv2"
+    }
+  }
+}
+
+data "influxdbv2_bucket" "example_bucket" {
+  name = "example_bucket_1"
+}
+
+output "retention" {
+  # e.g. "30d"
+  value = provider::influxdbv2::seconds_to_duration(one(data.influxdbv2_bucket.example_bucket.retention_rules).every_seconds)
+}
@@ -1,4 +1,10 @@
 provider "influxdbv2" {
   host  = "http://localhost:8086"
   token = "TOKEN"
+
+  // Optional, wait for a starting server before the first request
+  wait_for_ready = "2m"
+
+  // Optional, refuse to create, update or delete resources, e.g. in plan pipelines
+  read_only = false
 }
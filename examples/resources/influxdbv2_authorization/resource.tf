@@ -24,3 +24,17 @@ resource "influxdbv2_authorization" "example_auth" {
     }
   }
 }
+
+resource "influxdbv2_authorization" "encrypted_auth" {
+  org_id      = local.org_id
+  description = "token stored encrypted in the state"
+  pgp_key     = filebase64("${path.module}/public-key.gpg")
+  permissions {
+    action = "write"
+    resource {
+      id     = influxdbv2_bucket.example_bucket.id
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
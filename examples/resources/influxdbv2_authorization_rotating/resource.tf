@@ -0,0 +1,15 @@
+resource "influxdbv2_authorization_rotating" "telegraf_write" {
+  org_id             = "example_org_id"
+  description        = "telegraf write token"
+  rotation_days      = 90
+  grace_period_hours = 48
+
+  permissions {
+    action = "write"
+    resource {
+      id     = "example_bucket_id"
+      org_id = "example_org_id"
+      type   = "buckets"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+resource "influxdbv2_bucket_token" "grafana" {
+  org_id          = "example_org_id"
+  description     = "grafana read access"
+  read_bucket_ids = ["example_bucket_id"]
+  read_dashboards = true
+}
+
+resource "influxdbv2_bucket_token" "telegraf" {
+  org_id           = "example_org_id"
+  description      = "telegraf write access"
+  write_bucket_ids = ["example_bucket_id"]
+  read_telegrafs   = true
+}
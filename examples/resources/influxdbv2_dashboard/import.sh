@@ -0,0 +1 @@
+terraform import influxdbv2_dashboard.example_dashboard <DASHBOARD_ID>
\ No newline at end of file
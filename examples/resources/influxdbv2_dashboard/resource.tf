@@ -0,0 +1,53 @@
+resource "influxdbv2_dashboard" "example_dashboard" {
+  name        = "example_dashboard"
+  org_id      = "example_org_id"
+  description = "example description"
+
+  cell {
+    name = "CPU usage"
+    x    = 0
+    y    = 0
+    w    = 6
+    h    = 4
+    properties = jsonencode({
+      type  = "xy"
+      shape = "chronograf-v2"
+      geom  = "line"
+      queries = [{
+        text     = "from(bucket: \"example_bucket_1\") |> range(start: v.timeRangeStart) |> filter(fn: (r) => r._measurement == \"cpu\")"
+        editMode = "advanced"
+        name     = ""
+        builderConfig = {
+          buckets   = []
+          tags      = []
+          functions = []
+          aggregateWindow = {
+            period     = "auto"
+            fillValues = false
+          }
+        }
+      }]
+      axes = {
+        x = { bounds = ["", ""], label = "", prefix = "", suffix = "", base = "10", scale = "linear" }
+        y = { bounds = ["", ""], label = "", prefix = "", suffix = "", base = "10", scale = "linear" }
+      }
+      colors            = []
+      note              = ""
+      showNoteWhenEmpty = false
+      position          = "overlaid"
+    })
+  }
+
+  cell {
+    name = "Notes"
+    x    = 6
+    y    = 0
+    w    = 6
+    h    = 4
+    properties = jsonencode({
+      type  = "markdown"
+      shape = "chronograf-v2"
+      note  = "Dashboard managed by Terraform."
+    })
+  }
+}
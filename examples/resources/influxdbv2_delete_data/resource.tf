@@ -0,0 +1,11 @@
+resource "influxdbv2_delete_data" "decommissioned_fleet" {
+  org_id    = "example_org_id"
+  bucket_id = "example_bucket_id"
+  start     = "1970-01-01T00:00:00Z"
+  stop      = "2022-09-01T00:00:00Z"
+  predicate = "_measurement=\"sensors\" AND fleet=\"fleet-7\""
+
+  triggers = {
+    fleet = "fleet-7"
+  }
+}
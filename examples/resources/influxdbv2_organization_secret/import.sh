@@ -0,0 +1 @@
+terraform import influxdbv2_organization_secret.slack_webhook <ORG_ID>/<SECRET_KEY>
\ No newline at end of file
@@ -0,0 +1,13 @@
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+resource "influxdbv2_organization_secret" "slack_webhook" {
+  org_id = "example_org_id"
+  key    = "SLACK_WEBHOOK_URL"
+  value  = var.slack_webhook_url
+
+  # Increment after changing the webhook URL to update the secret.
+  value_version = 1
+}
@@ -0,0 +1,33 @@
+resource "influxdbv2_bucket" "example_bucket" {
+  name   = "example_bucket_1"
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_points" "slo_targets" {
+  org_id    = influxdbv2_bucket.example_bucket.org_id
+  bucket_id = influxdbv2_bucket.example_bucket.id
+  precision = "s"
+
+  line_protocol = <<-EOT
+    slo,service=api target=99.9
+    slo,service=web target=99.5
+  EOT
+}
+
+resource "influxdbv2_points" "site_metadata" {
+  org_id    = influxdbv2_bucket.example_bucket.org_id
+  bucket_id = influxdbv2_bucket.example_bucket.id
+
+  point {
+    measurement = "site"
+    tags = {
+      site = "vilnius"
+    }
+    fields = {
+      name     = "\"Vilnius DC\""
+      capacity = "42i"
+      active   = "true"
+    }
+    timestamp = "2022-01-01T00:00:00Z"
+  }
+}
@@ -0,0 +1 @@
+terraform import influxdbv2_remote_connection.cloud <REMOTE_ID>
\ No newline at end of file
@@ -0,0 +1,12 @@
+variable "cloud_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "influxdbv2_remote_connection" "cloud" {
+  name             = "central"
+  org_id           = "example_org_id"
+  remote_url       = "https://influxdb.example.com"
+  remote_org_id    = "example_remote_org_id"
+  remote_api_token = var.cloud_token
+}
@@ -0,0 +1 @@
+terraform import influxdbv2_replication.edge_to_cloud <REPLICATION_ID>
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "influxdbv2_bucket" "edge_bucket" {
+  name   = "edge"
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_replication" "edge_to_cloud" {
+  name                    = "edge-to-cloud"
+  org_id                  = influxdbv2_bucket.edge_bucket.org_id
+  remote_id               = influxdbv2_remote_connection.cloud.id
+  local_bucket_id         = influxdbv2_bucket.edge_bucket.id
+  remote_bucket_id        = "example_remote_bucket_id"
+  max_queue_size_bytes    = 67108860
+  drop_non_retryable_data = true
+}
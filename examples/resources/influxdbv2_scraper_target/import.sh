@@ -0,0 +1 @@
+terraform import influxdbv2_scraper_target.example_scraper <SCRAPER_TARGET_ID>
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "influxdbv2_bucket" "example_bucket" {
+  name   = "example_bucket_1"
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_scraper_target" "example_scraper" {
+  name      = "example_service"
+  url       = "http://example-service:9100/metrics"
+  type      = "prometheus"
+  bucket_id = influxdbv2_bucket.example_bucket.id
+  org_id    = influxdbv2_bucket.example_bucket.org_id
+}
@@ -0,0 +1 @@
+terraform import influxdbv2_stack.monitoring <STACK_ID>
\ No newline at end of file
@@ -0,0 +1,27 @@
+resource "influxdbv2_stack" "monitoring" {
+  org_id      = "example_org_id"
+  name        = "monitoring"
+  description = "Monitoring dashboards and buckets"
+
+  urls  = ["https://raw.githubusercontent.com/influxdata/community-templates/master/docker/docker.yml"]
+  files = ["${path.module}/templates/alerts.yml"]
+
+  templates = [<<-EOT
+    apiVersion: influxdata.com/v2alpha1
+    kind: Bucket
+    metadata:
+      name: metrics
+    spec:
+      name:
+        envRef:
+          key: bucket
+      retentionRules:
+        - type: expire
+          everySeconds: 604800
+  EOT
+  ]
+
+  env_refs = {
+    bucket = "metrics"
+  }
+}
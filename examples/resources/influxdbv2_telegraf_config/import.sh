@@ -0,0 +1 @@
+terraform import influxdbv2_telegraf_config.example_telegraf <TELEGRAF_ID>
\ No newline at end of file
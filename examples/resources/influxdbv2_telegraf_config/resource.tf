@@ -0,0 +1,19 @@
+resource "influxdbv2_telegraf_config" "example_telegraf" {
+  name        = "example_telegraf"
+  org_id      = "example_org_id"
+  description = "example description"
+  config      = <<-EOT
+    [[outputs.influxdb_v2]]
+      urls = ["http://localhost:8086"]
+      token = "$INFLUX_TOKEN"
+      organization = "example_org"
+      bucket = "example_bucket_1"
+
+    [[inputs.cpu]]
+      percpu = true
+  EOT
+
+  metadata {
+    buckets = ["example_bucket_1"]
+  }
+}
@@ -0,0 +1,3 @@
+terraform import influxdbv2_variable.site <VARIABLE_ID>
+# or
+terraform import influxdbv2_variable.site <ORG_ID>/<VARIABLE_NAME>
\ No newline at end of file
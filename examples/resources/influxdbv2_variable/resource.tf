@@ -0,0 +1,31 @@
+locals {
+  org_id = "example_org_id"
+}
+
+resource "influxdbv2_variable" "site" {
+  name   = "site"
+  org_id = local.org_id
+  query {
+    query = "import \"influxdata/influxdb/schema\"\nschema.tagValues(bucket: \"example_bucket_1\", tag: \"site\")"
+  }
+}
+
+resource "influxdbv2_variable" "env" {
+  name     = "env"
+  org_id   = local.org_id
+  selected = ["production"]
+  constant {
+    values = ["production", "staging"]
+  }
+}
+
+resource "influxdbv2_variable" "region" {
+  name   = "region"
+  org_id = local.org_id
+  map {
+    values = {
+      "Europe" = "eu-central-1"
+      "US"     = "us-east-1"
+    }
+  }
+}
@@ -0,0 +1,205 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"io"
+	nethttp "net/http"
+	"time"
+)
+
+// Resources and data sources reach InfluxDB only through the interfaces below, one per API area.
+// Each interface holds just the calls the provider makes. Retries, caching, logging or fakes can then be
+// plugged in for an area in newClientAPI, which builds the areas from influxdb-client-go.
+
+// influxdbAPI groups the API areas the provider uses.
+type influxdbAPI struct {
+	instance       instanceAPI
+	buckets        bucketsAPI
+	authorizations authorizationsAPI
+	organizations  organizationsAPI
+	write          writeAPI
+	delete         deleteAPI
+	telegrafs      telegrafsAPI
+	scrapers       scrapersAPI
+	dashboards     dashboardsAPI
+	variables      variablesAPI
+	remotes        remotesAPI
+	replications   replicationsAPI
+	templates      templatesAPI
+}
+
+// instanceAPI reports the state of the InfluxDB instance.
+type instanceAPI interface {
+	ServerURL() string
+	Health(ctx context.Context) (*domain.HealthCheck, error)
+	Ready(ctx context.Context) (*domain.Ready, error)
+}
+
+type bucketsAPI interface {
+	FindBucketByID(ctx context.Context, bucketID string) (*domain.Bucket, error)
+	FindBucketByName(ctx context.Context, bucketName string) (*domain.Bucket, error)
+	CreateBucket(ctx context.Context, bucket *domain.Bucket) (*domain.Bucket, error)
+	UpdateBucket(ctx context.Context, bucket *domain.Bucket) (*domain.Bucket, error)
+	DeleteBucketWithID(ctx context.Context, bucketID string) error
+}
+
+type authorizationsAPI interface {
+	GetAuthorizations(ctx context.Context) (*[]domain.Authorization, error)
+	GetAuthorizationsIDWithResponse(ctx context.Context, authID string, params *domain.GetAuthorizationsIDParams) (*domain.GetAuthorizationsIDResponse, error)
+	CreateAuthorization(ctx context.Context, authorization *domain.Authorization) (*domain.Authorization, error)
+	UpdateAuthorizationStatusWithID(ctx context.Context, authID string, status domain.AuthorizationUpdateRequestStatus) (*domain.Authorization, error)
+	DeleteAuthorizationWithID(ctx context.Context, authID string) error
+	DeleteAuthorizationsIDWithResponse(ctx context.Context, authID string, params *domain.DeleteAuthorizationsIDParams) (*domain.DeleteAuthorizationsIDResponse, error)
+}
+
+// organizationsAPI manages the secrets of organizations.
+type organizationsAPI interface {
+	GetOrgsIDSecretsWithResponse(ctx context.Context, orgID string, params *domain.GetOrgsIDSecretsParams) (*domain.GetOrgsIDSecretsResponse, error)
+	PatchOrgsIDSecretsWithBodyWithResponse(ctx context.Context, orgID string, params *domain.PatchOrgsIDSecretsParams, contentType string, body io.Reader) (*domain.PatchOrgsIDSecretsResponse, error)
+	PostOrgsIDSecretsWithResponse(ctx context.Context, orgID string, params *domain.PostOrgsIDSecretsParams, body domain.PostOrgsIDSecretsJSONRequestBody) (*domain.PostOrgsIDSecretsResponse, error)
+}
+
+// writeAPI writes points into a bucket.
+type writeAPI interface {
+	WriteRecord(ctx context.Context, orgId, bucketId string, precision time.Duration, lines ...string) error
+	WritePoint(ctx context.Context, orgId, bucketId string, precision time.Duration, points ...*write.Point) error
+}
+
+// deleteAPI deletes points from a bucket.
+type deleteAPI interface {
+	DeleteWithID(ctx context.Context, orgID, bucketID string, start, stop time.Time, predicate string) error
+}
+
+type telegrafsAPI interface {
+	GetTelegrafsIDWithResponse(ctx context.Context, telegrafID string, params *domain.GetTelegrafsIDParams) (*domain.GetTelegrafsIDResponse, error)
+	PostTelegrafsWithResponse(ctx context.Context, params *domain.PostTelegrafsParams, body domain.PostTelegrafsJSONRequestBody) (*domain.PostTelegrafsResponse, error)
+	PutTelegrafsIDWithResponse(ctx context.Context, telegrafID string, params *domain.PutTelegrafsIDParams, body domain.PutTelegrafsIDJSONRequestBody) (*domain.PutTelegrafsIDResponse, error)
+	DeleteTelegrafsIDWithResponse(ctx context.Context, telegrafID string, params *domain.DeleteTelegrafsIDParams) (*domain.DeleteTelegrafsIDResponse, error)
+}
+
+type scrapersAPI interface {
+	GetScrapersIDWithResponse(ctx context.Context, scraperTargetID string, params *domain.GetScrapersIDParams) (*domain.GetScrapersIDResponse, error)
+	PostScrapersWithResponse(ctx context.Context, params *domain.PostScrapersParams, body domain.PostScrapersJSONRequestBody) (*domain.PostScrapersResponse, error)
+	PatchScrapersIDWithResponse(ctx context.Context, scraperTargetID string, params *domain.PatchScrapersIDParams, body domain.PatchScrapersIDJSONRequestBody) (*domain.PatchScrapersIDResponse, error)
+	DeleteScrapersIDWithResponse(ctx context.Context, scraperTargetID string, params *domain.DeleteScrapersIDParams) (*domain.DeleteScrapersIDResponse, error)
+}
+
+type dashboardsAPI interface {
+	GetDashboardsIDWithResponse(ctx context.Context, dashboardID string, params *domain.GetDashboardsIDParams) (*domain.GetDashboardsIDResponse, error)
+	PostDashboardsWithResponse(ctx context.Context, params *domain.PostDashboardsParams, body domain.PostDashboardsJSONRequestBody) (*domain.PostDashboardsResponse, error)
+	PatchDashboardsIDWithResponse(ctx context.Context, dashboardID string, params *domain.PatchDashboardsIDParams, body domain.PatchDashboardsIDJSONRequestBody) (*domain.PatchDashboardsIDResponse, error)
+	DeleteDashboardsIDWithResponse(ctx context.Context, dashboardID string, params *domain.DeleteDashboardsIDParams) (*domain.DeleteDashboardsIDResponse, error)
+	PostDashboardsIDCellsWithResponse(ctx context.Context, dashboardID string, params *domain.PostDashboardsIDCellsParams, body domain.PostDashboardsIDCellsJSONRequestBody) (*domain.PostDashboardsIDCellsResponse, error)
+	PatchDashboardsIDCellsIDWithResponse(ctx context.Context, dashboardID string, cellID string, params *domain.PatchDashboardsIDCellsIDParams, body domain.PatchDashboardsIDCellsIDJSONRequestBody) (*domain.PatchDashboardsIDCellsIDResponse, error)
+	PatchDashboardsIDCellsIDViewWithResponse(ctx context.Context, dashboardID string, cellID string, params *domain.PatchDashboardsIDCellsIDViewParams, body domain.PatchDashboardsIDCellsIDViewJSONRequestBody) (*domain.PatchDashboardsIDCellsIDViewResponse, error)
+	DeleteDashboardsIDCellsIDWithResponse(ctx context.Context, dashboardID string, cellID string, params *domain.DeleteDashboardsIDCellsIDParams) (*domain.DeleteDashboardsIDCellsIDResponse, error)
+}
+
+type variablesAPI interface {
+	GetVariablesWithResponse(ctx context.Context, params *domain.GetVariablesParams) (*domain.GetVariablesResponse, error)
+	GetVariablesIDWithResponse(ctx context.Context, variableID string, params *domain.GetVariablesIDParams) (*domain.GetVariablesIDResponse, error)
+	PostVariablesWithResponse(ctx context.Context, params *domain.PostVariablesParams, body domain.PostVariablesJSONRequestBody) (*domain.PostVariablesResponse, error)
+	PutVariablesIDWithResponse(ctx context.Context, variableID string, params *domain.PutVariablesIDParams, body domain.PutVariablesIDJSONRequestBody) (*domain.PutVariablesIDResponse, error)
+	DeleteVariablesIDWithResponse(ctx context.Context, variableID string, params *domain.DeleteVariablesIDParams) (*domain.DeleteVariablesIDResponse, error)
+	PostVariablesIDLabelsWithResponse(ctx context.Context, variableID string, params *domain.PostVariablesIDLabelsParams, body domain.PostVariablesIDLabelsJSONRequestBody) (*domain.PostVariablesIDLabelsResponse, error)
+	DeleteVariablesIDLabelsIDWithResponse(ctx context.Context, variableID string, labelID string, params *domain.DeleteVariablesIDLabelsIDParams) (*domain.DeleteVariablesIDLabelsIDResponse, error)
+}
+
+type remotesAPI interface {
+	GetRemoteConnectionByIDWithResponse(ctx context.Context, remoteID string, params *domain.GetRemoteConnectionByIDParams) (*domain.GetRemoteConnectionByIDResponse, error)
+	PostRemoteConnectionWithResponse(ctx context.Context, body domain.PostRemoteConnectionJSONRequestBody) (*domain.PostRemoteConnectionResponse, error)
+	PatchRemoteConnectionByIDWithResponse(ctx context.Context, remoteID string, params *domain.PatchRemoteConnectionByIDParams, body domain.PatchRemoteConnectionByIDJSONRequestBody) (*domain.PatchRemoteConnectionByIDResponse, error)
+	DeleteRemoteConnectionByIDWithResponse(ctx context.Context, remoteID string, params *domain.DeleteRemoteConnectionByIDParams) (*domain.DeleteRemoteConnectionByIDResponse, error)
+}
+
+type replicationsAPI interface {
+	GetReplicationByIDWithResponse(ctx context.Context, replicationID string, params *domain.GetReplicationByIDParams) (*domain.GetReplicationByIDResponse, error)
+	PostReplicationWithResponse(ctx context.Context, params *domain.PostReplicationParams, body domain.PostReplicationJSONRequestBody) (*domain.PostReplicationResponse, error)
+	PatchReplicationByIDWithResponse(ctx context.Context, replicationID string, params *domain.PatchReplicationByIDParams, body domain.PatchReplicationByIDJSONRequestBody) (*domain.PatchReplicationByIDResponse, error)
+	DeleteReplicationByIDWithResponse(ctx context.Context, replicationID string, params *domain.DeleteReplicationByIDParams) (*domain.DeleteReplicationByIDResponse, error)
+}
+
+// templatesAPI applies and exports templates and manages the stacks they are installed in.
+type templatesAPI interface {
+	ApplyTemplateWithBody(ctx context.Context, contentType string, body io.Reader) (*nethttp.Response, error)
+	ExportTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*domain.ExportTemplateResponse, error)
+	ReadStackWithResponse(ctx context.Context, stackId string) (*domain.ReadStackResponse, error)
+	CreateStackWithResponse(ctx context.Context, body domain.CreateStackJSONRequestBody) (*domain.CreateStackResponse, error)
+	UpdateStackWithResponse(ctx context.Context, stackId string, body domain.UpdateStackJSONRequestBody) (*domain.UpdateStackResponse, error)
+	UninstallStackWithResponse(ctx context.Context, stackId string, orgId string) (*domain.UninstallStackResponse, error)
+	DeleteStackWithResponse(ctx context.Context, stackId string, params *domain.DeleteStackParams) (*domain.DeleteStackResponse, error)
+}
+
+// newClientAPI returns the API areas implemented by the client.
+func newClientAPI(client influxdb2.Client) influxdbAPI {
+	generated := domain.NewClientWithResponses(client.HTTPService())
+
+	return influxdbAPI{
+		instance:       client,
+		buckets:        client.BucketsAPI(),
+		authorizations: clientAuthorizationsAPI{client.AuthorizationsAPI(), generated},
+		organizations:  generated,
+		write:          clientWriteAPI{client.HTTPService()},
+		delete:         client.DeleteAPI(),
+		telegrafs:      generated,
+		scrapers:       generated,
+		dashboards:     generated,
+		variables:      generated,
+		remotes:        generated,
+		replications:   generated,
+		templates:      clientTemplatesAPI{generated, client.HTTPService()},
+	}
+}
+
+// clientAuthorizationsAPI adds the calls missing from the authorizations API of the client from the generated client.
+type clientAuthorizationsAPI struct {
+	api.AuthorizationsAPI
+	*domain.ClientWithResponses
+}
+
+// clientTemplatesAPI uninstalls stacks with the orgID query parameter InfluxDB requires, which the generated client
+// does not send.
+type clientTemplatesAPI struct {
+	*domain.ClientWithResponses
+	service http.Service
+}
+
+func (t clientTemplatesAPI) UninstallStackWithResponse(ctx context.Context, stackId string, orgId string) (*domain.UninstallStackResponse, error) {
+	request, err := domain.NewUninstallStackRequest(t.service.ServerAPIURL(), stackId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := request.URL.Query()
+	query.Set("orgID", orgId)
+	request.URL.RawQuery = query.Encode()
+
+	response, err := t.service.DoHTTPRequestWithResponse(request.WithContext(ctx), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ParseUninstallStackResponse(response)
+}
+
+// clientWriteAPI writes through a blocking write API of the client per bucket and precision.
+type clientWriteAPI struct {
+	service http.Service
+}
+
+func (w clientWriteAPI) WriteRecord(ctx context.Context, orgId, bucketId string, precision time.Duration, lines ...string) error {
+	return w.writeAPI(orgId, bucketId, precision).WriteRecord(ctx, lines...)
+}
+
+func (w clientWriteAPI) WritePoint(ctx context.Context, orgId, bucketId string, precision time.Duration, points ...*write.Point) error {
+	return w.writeAPI(orgId, bucketId, precision).WritePoint(ctx, points...)
+}
+
+func (w clientWriteAPI) writeAPI(orgId, bucketId string, precision time.Duration) api.WriteAPIBlocking {
+	return api.NewWriteAPIBlocking(orgId, bucketId, w.service, write.DefaultOptions().SetPrecision(precision))
+}
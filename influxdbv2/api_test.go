@@ -0,0 +1,63 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"testing"
+	"time"
+)
+
+// testBucketsAPI is a buckets API area holding buckets in memory.
+type testBucketsAPI struct {
+	bucketsAPI
+	buckets map[string]*domain.Bucket
+	reads   int
+}
+
+func (b *testBucketsAPI) FindBucketByID(ctx context.Context, bucketID string) (*domain.Bucket, error) {
+	b.reads++
+	return b.buckets[bucketID], nil
+}
+
+func TestDataSourceBucketReadWithTestAPI(t *testing.T) {
+	orgId := "0a1b2c3d4e5f0001"
+	bucketId := "0a1b2c3d4e5f0002"
+	description := "raw metrics"
+	bucketType := domain.BucketTypeUser
+	now := time.Now()
+	buckets := &testBucketsAPI{buckets: map[string]*domain.Bucket{
+		bucketId: {
+			Id:             &bucketId,
+			OrgID:          &orgId,
+			Name:           "metrics",
+			Description:    &description,
+			Type:           &bucketType,
+			CreatedAt:      &now,
+			UpdatedAt:      &now,
+			RetentionRules: domain.RetentionRules{{EverySeconds: 86400}},
+		},
+	}}
+	meta := &providerMeta{influxdbAPI: influxdbAPI{buckets: buckets}}
+
+	data := dataSourceBucket().TestResourceData()
+	data.Set("id", bucketId)
+
+	if diags := dataSourceBucketRead(context.Background(), data, meta); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if buckets.reads != 1 {
+		t.Errorf("expected 1 read of the buckets API, got %d", buckets.reads)
+	}
+	for key, expected := range map[string]string{
+		"name":              "metrics",
+		"org_id":            orgId,
+		"description":       description,
+		"type":              "user",
+		"retention_rules.#": "1",
+	} {
+		if actual := data.State().Attributes[key]; actual != expected {
+			t.Errorf("expected %s to be %q, got %q", key, expected, actual)
+		}
+	}
+}
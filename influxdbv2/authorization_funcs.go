@@ -1,11 +1,107 @@
 package influxdbv2
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
+// Descriptions of the permissions of an authorization, shared by the SDK and the framework schemas.
+const (
+	permissionsDescription        = "List of permissions for an authorization. An authorization must have at least one permission."
+	permissionResourceDescription = "Resource info. Exactly one resource is required."
+)
+
+// permissionAttribute is a string attribute of a permission or of its resource. The authorization resource and the
+// ephemeral authorization resource build their permissions block from the same attributes, as their schema
+// packages have distinct types.
+type permissionAttribute struct {
+	description string
+	required    bool
+	validators  []validator.String
+}
+
+var (
+	permissionsValidators = []validator.Set{
+		setvalidator.IsRequired(),
+	}
+	permissionResourceValidators = []validator.Set{
+		setvalidator.IsRequired(),
+		setvalidator.SizeBetween(1, 1),
+	}
+
+	permissionAttributes = map[string]permissionAttribute{
+		"action": {
+			description: "Enum: 'read'|'write'.",
+			required:    true,
+		},
+	}
+	permissionResourceAttributes = map[string]permissionAttribute{
+		"type": {
+			description: "Type of resource.",
+			required:    true,
+		},
+		"id": {
+			description: "If ID is set, that is a permission for a specific resource. If it is not set, it is a permission for all resources of that resource type.",
+			validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"org_id": {
+			description: "If orgID is set, that is a permission for all resources owned by that org. If it is not set, it is a permission for all resources of that resource type.",
+			validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+)
+
+func authorizationPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: permissionsDescription,
+		Type:        schema.TypeSet,
+		Required:    true,
+		ForceNew:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Description: permissionAttributes["action"].description,
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"resource": {
+					Description: "Resource info.",
+					Type:        schema.TypeSet,
+					Required:    true,
+					MaxItems:    1,
+					MinItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Description: permissionResourceAttributes["type"].description,
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+							},
+							"id": {
+								Description: permissionResourceAttributes["id"].description,
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    true,
+							},
+							"org_id": {
+								Description: permissionResourceAttributes["org_id"].description,
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func setAuthorizationData(data *schema.ResourceData, authorization *domain.Authorization) diag.Diagnostics {
 	data.Set("org_id", *authorization.OrgID)
 	data.Set("description", authorization.Description)
@@ -23,8 +119,71 @@ func setAuthorizationData(data *schema.ResourceData, authorization *domain.Autho
 		break
 	}
 
-	var permissions []map[string]interface{}
-	for _, permission := range *authorization.Permissions {
+	data.Set("permissions", flattenPermissions(*authorization.Permissions))
+
+	return nil
+}
+
+// setAuthorizationTokenData keeps the plaintext token out of the state when a PGP key is set,
+// storing the token encrypted with the key instead. An already encrypted token is kept as is.
+func setAuthorizationTokenData(data *schema.ResourceData, token *string) diag.Diagnostics {
+	pgpKey := data.Get("pgp_key").(string)
+	if pgpKey == "" {
+		data.Set("token", token)
+		return nil
+	}
+
+	data.Set("token", "")
+
+	if token == nil || data.Get("encrypted_token").(string) != "" {
+		return nil
+	}
+
+	fingerprint, encryptedToken, err := encryptWithPGPKey(pgpKey, *token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("key_fingerprint", fingerprint)
+	data.Set("encrypted_token", encryptedToken)
+
+	return nil
+}
+
+func mapToPermissions(permissionsData *schema.Set) []domain.Permission {
+	var permissions []domain.Permission
+	for _, permissionData := range permissionsData.List() {
+		permissionDataMap := permissionData.(map[string]interface{})
+		resourceDataMap := permissionDataMap["resource"].(*schema.Set).List()[0].(map[string]interface{})
+
+		// Unset IDs are empty strings in the set, which InfluxDB rejects as invalid IDs.
+		resourceId, _ := resourceDataMap["id"].(string)
+		resourceOrgId, _ := resourceDataMap["org_id"].(string)
+
+		permission := domain.Permission{
+			Action: domain.PermissionAction(permissionDataMap["action"].(string)),
+			Resource: domain.Resource{
+				Type: domain.ResourceType(resourceDataMap["type"].(string)),
+			},
+		}
+
+		if resourceId != "" {
+			permission.Resource.Id = &resourceId
+		}
+
+		if resourceOrgId != "" {
+			permission.Resource.OrgID = &resourceOrgId
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions
+}
+
+func flattenPermissions(permissions []domain.Permission) []map[string]interface{} {
+	var permissionsData []map[string]interface{}
+	for _, permission := range permissions {
 		tmp := map[string]interface{}{
 			"action": permission.Action,
 			"resource": []map[string]interface{}{
@@ -36,10 +195,8 @@ func setAuthorizationData(data *schema.ResourceData, authorization *domain.Autho
 			},
 		}
 
-		permissions = append(permissions, tmp)
+		permissionsData = append(permissionsData, tmp)
 	}
 
-	data.Set("permissions", permissions)
-
-	return nil
+	return permissionsData
 }
@@ -0,0 +1,148 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"time"
+)
+
+type rotationStep int
+
+const (
+	rotationStepNone rotationStep = iota
+	rotationStepRotate
+	rotationStepDeactivatePrevious
+	rotationStepDeletePrevious
+)
+
+// rotationState is implemented by both schema.ResourceData and schema.ResourceDiff.
+type rotationState interface {
+	Get(key string) interface{}
+}
+
+// nextRotationStep decides which step of the rotation is due at the given time.
+func nextRotationStep(diff rotationState, now time.Time) rotationStep {
+	createdAt, err := time.Parse(time.RFC3339, diff.Get("created_at").(string))
+	if err != nil {
+		return rotationStepNone
+	}
+
+	rotationPeriod := time.Duration(diff.Get("rotation_days").(int)) * 24 * time.Hour
+	gracePeriod := time.Duration(diff.Get("grace_period_hours").(int)) * time.Hour
+
+	if !now.Before(createdAt.Add(rotationPeriod)) {
+		return rotationStepRotate
+	}
+
+	if diff.Get("previous_authorization_id").(string) == "" {
+		return rotationStepNone
+	}
+
+	if diff.Get("previous_active").(bool) {
+		if !now.Before(createdAt.Add(gracePeriod)) {
+			return rotationStepDeactivatePrevious
+		}
+		return rotationStepNone
+	}
+
+	deactivatedAt, err := time.Parse(time.RFC3339, diff.Get("previous_deactivated_at").(string))
+	if err != nil || !now.Before(deactivatedAt.Add(gracePeriod)) {
+		return rotationStepDeletePrevious
+	}
+
+	return rotationStepNone
+}
+
+// validateRotationGracePeriod rejects grace periods that do not fit into the rotation period. A rotation replaces the
+// previous token, so it must have been deactivated and deleted after its grace periods before the next rotation.
+func validateRotationGracePeriod(diff rotationState) error {
+	rotationHours := diff.Get("rotation_days").(int) * 24
+	gracePeriodHours := diff.Get("grace_period_hours").(int)
+
+	if 2*gracePeriodHours > rotationHours {
+		return fmt.Errorf("grace_period_hours %d does not fit into rotation_days %d: the previous token is deleted two grace periods after a rotation, at most %d hours may pass until the next one", gracePeriodHours, diff.Get("rotation_days").(int), rotationHours)
+	}
+
+	return nil
+}
+
+func createRotatingAuthorization(ctx context.Context, authClient authorizationsAPI, data *schema.ResourceData) (*domain.Authorization, error) {
+	orgId := data.Get("org_id").(string)
+	status := domain.AuthorizationUpdateRequestStatusActive
+	permissions := mapToPermissions(data.Get("permissions").(*schema.Set))
+
+	authorization := &domain.Authorization{
+		OrgID:       &orgId,
+		Permissions: &permissions,
+	}
+	authorization.Status = &status
+
+	if userId, ok := data.GetOk("user_id"); ok {
+		tmp := userId.(string)
+		authorization.UserID = &tmp
+	}
+
+	if description, ok := data.GetOk("description"); ok {
+		tmp := description.(string)
+		authorization.Description = &tmp
+	}
+
+	return authClient.CreateAuthorization(ctx, authorization)
+}
+
+// findAuthorization returns the authorization with the given ID, or nil if it does not exist.
+func findAuthorization(ctx context.Context, authClient authorizationsAPI, id string) (*domain.Authorization, error) {
+	response, err := authClient.GetAuthorizationsIDWithResponse(ctx, id, &domain.GetAuthorizationsIDParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if response.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode())
+	}
+
+	if response.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response reading authorization %s: %s", id, response.Status())
+	}
+
+	return response.JSON200, nil
+}
+
+// deleteAuthorization deletes the authorization with the given ID, ignoring authorizations that are already gone.
+func deleteAuthorization(ctx context.Context, authClient authorizationsAPI, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	response, err := authClient.DeleteAuthorizationsIDWithResponse(ctx, id, &domain.DeleteAuthorizationsIDParams{})
+	if err != nil {
+		return err
+	}
+
+	if response.JSONDefault != nil && response.StatusCode() != http.StatusNotFound {
+		return domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode())
+	}
+
+	return nil
+}
+
+func setCurrentAuthorizationData(data *schema.ResourceData, authorization *domain.Authorization) {
+	data.Set("authorization_id", authorization.Id)
+	data.Set("token", authorization.Token)
+	data.Set("user_id", authorization.UserID)
+	data.Set("created_at", authorization.CreatedAt.UTC().Format(time.RFC3339))
+}
+
+func clearPreviousAuthorizationData(data *schema.ResourceData) {
+	data.Set("previous_authorization_id", "")
+	data.Set("previous_token", "")
+	data.Set("previous_active", false)
+	data.Set("previous_deactivated_at", "")
+}
@@ -0,0 +1,112 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"sort"
+)
+
+func setBucketTokenData(data *schema.ResourceData, authorization *domain.Authorization) diag.Diagnostics {
+	data.Set("org_id", authorization.OrgID)
+	data.Set("description", authorization.Description)
+	data.Set("token", authorization.Token)
+	data.Set("created_at", authorization.CreatedAt.String())
+	data.Set("updated_at", authorization.UpdatedAt.String())
+	data.Set("active", *authorization.Status == domain.AuthorizationUpdateRequestStatusActive)
+
+	var permissions []domain.Permission
+	if authorization.Permissions != nil {
+		permissions = *authorization.Permissions
+	}
+	for key, value := range flattenBucketTokenPermissions(permissions) {
+		if err := data.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func bucketTokenStatus(active bool) *domain.AuthorizationUpdateRequestStatus {
+	status := domain.AuthorizationUpdateRequestStatusInactive
+	if active {
+		status = domain.AuthorizationUpdateRequestStatusActive
+	}
+	return &status
+}
+
+// mapToBucketTokenPermissions expands the bucket IDs and flags into permissions scoped to the organization.
+func mapToBucketTokenPermissions(data *schema.ResourceData) []domain.Permission {
+	orgId := data.Get("org_id").(string)
+
+	var permissions []domain.Permission
+	addPermissions := func(key string, action domain.PermissionAction) {
+		bucketIds := stringList(data.Get(key).(*schema.Set).List())
+		sort.Strings(bucketIds)
+		for _, bucketId := range bucketIds {
+			permissions = append(permissions, orgPermission(action, domain.ResourceTypeBuckets, orgId, bucketId))
+		}
+	}
+
+	addPermissions("read_bucket_ids", domain.PermissionActionRead)
+	addPermissions("write_bucket_ids", domain.PermissionActionWrite)
+
+	if data.Get("read_tasks").(bool) {
+		permissions = append(permissions, orgPermission(domain.PermissionActionRead, domain.ResourceTypeTasks, orgId, ""))
+	}
+	if data.Get("read_dashboards").(bool) {
+		permissions = append(permissions, orgPermission(domain.PermissionActionRead, domain.ResourceTypeDashboards, orgId, ""))
+	}
+	if data.Get("read_telegrafs").(bool) {
+		permissions = append(permissions, orgPermission(domain.PermissionActionRead, domain.ResourceTypeTelegrafs, orgId, ""))
+	}
+
+	return permissions
+}
+
+// flattenBucketTokenPermissions maps permissions back to the bucket IDs and flags of mapToBucketTokenPermissions.
+// Permissions the resource can not express are ignored.
+func flattenBucketTokenPermissions(permissions []domain.Permission) map[string]interface{} {
+	readBucketIds := []string{}
+	writeBucketIds := []string{}
+	flags := map[domain.ResourceType]bool{}
+
+	for _, permission := range permissions {
+		id := stringValue(permission.Resource.Id)
+
+		switch {
+		case permission.Resource.Type == domain.ResourceTypeBuckets && id != "" && permission.Action == domain.PermissionActionRead:
+			readBucketIds = append(readBucketIds, id)
+		case permission.Resource.Type == domain.ResourceTypeBuckets && id != "" && permission.Action == domain.PermissionActionWrite:
+			writeBucketIds = append(writeBucketIds, id)
+		case id == "" && permission.Action == domain.PermissionActionRead:
+			flags[permission.Resource.Type] = true
+		}
+	}
+
+	return map[string]interface{}{
+		"read_bucket_ids":  readBucketIds,
+		"write_bucket_ids": writeBucketIds,
+		"read_tasks":       flags[domain.ResourceTypeTasks],
+		"read_dashboards":  flags[domain.ResourceTypeDashboards],
+		"read_telegrafs":   flags[domain.ResourceTypeTelegrafs],
+	}
+}
+
+// orgPermission builds a permission on the resources of the organization, or on a single resource if an ID is given.
+func orgPermission(action domain.PermissionAction, resourceType domain.ResourceType, orgId string, id string) domain.Permission {
+	permission := domain.Permission{
+		Action: action,
+		Resource: domain.Resource{
+			Type:  resourceType,
+			OrgID: &orgId,
+		},
+	}
+
+	if id != "" {
+		permission.Resource.Id = &id
+	}
+
+	return permission
+}
@@ -0,0 +1,186 @@
+package influxdbv2
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"reflect"
+)
+
+func setDashboardData(data *schema.ResourceData, dashboard *domain.DashboardWithViewProperties) diag.Diagnostics {
+	data.Set("org_id", dashboard.OrgID)
+	data.Set("name", dashboard.Name)
+	data.Set("description", dashboard.Description)
+
+	var cells domain.CellsWithViewProperties
+	if dashboard.Cells != nil {
+		cells = *dashboard.Cells
+	}
+
+	// Keep the cells in the order they have in the state, new cells are added at the end.
+	position := map[string]int{}
+	for _, cellData := range data.Get("cell").([]interface{}) {
+		if cellData == nil {
+			continue
+		}
+		if id := cellData.(map[string]interface{})["id"].(string); id != "" {
+			position[id] = len(position)
+		}
+	}
+
+	ordered := make([]map[string]interface{}, len(position))
+	var unknown []map[string]interface{}
+	for _, cell := range cells {
+		mapped := map[string]interface{}{
+			"id":   cell.Id,
+			"name": cell.Name,
+			"x":    cell.X,
+			"y":    cell.Y,
+			"w":    cell.W,
+			"h":    cell.H,
+		}
+
+		if cell.Properties != nil {
+			properties, err := json.Marshal(cell.Properties)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			mapped["properties"] = string(properties)
+		}
+
+		if i, ok := position[*cell.Id]; ok {
+			ordered[i] = mapped
+		} else {
+			unknown = append(unknown, mapped)
+		}
+	}
+
+	var mappedCells []map[string]interface{}
+	for _, cell := range append(ordered, unknown...) {
+		if cell != nil {
+			mappedCells = append(mappedCells, cell)
+		}
+	}
+
+	data.Set("cell", mappedCells)
+
+	return nil
+}
+
+// setDashboardCellIds stores the IDs of the configured cells, so they can be matched when reading the dashboard.
+func setDashboardCellIds(data *schema.ResourceData, cellIds []string) {
+	var cells []interface{}
+	for i, cellData := range data.Get("cell").([]interface{}) {
+		cell := cellData.(map[string]interface{})
+		cell["id"] = cellIds[i]
+		cells = append(cells, cell)
+	}
+
+	data.Set("cell", cells)
+}
+
+func mapToCellUpdate(data map[string]interface{}) domain.CellUpdate {
+	x := int32(data["x"].(int))
+	y := int32(data["y"].(int))
+	w := int32(data["w"].(int))
+	h := int32(data["h"].(int))
+
+	return domain.CellUpdate{
+		X: &x,
+		Y: &y,
+		W: &w,
+		H: &h,
+	}
+}
+
+func mapToView(data map[string]interface{}) (*domain.View, error) {
+	var properties interface{}
+	if err := json.Unmarshal([]byte(data["properties"].(string)), &properties); err != nil {
+		return nil, err
+	}
+
+	return &domain.View{
+		Name:       data["name"].(string),
+		Properties: properties,
+	}, nil
+}
+
+// suppressEquivalentViewPropertiesDiff ignores properties InfluxDB returns with default values
+// when they are not set in the configuration.
+func suppressEquivalentViewPropertiesDiff(k, old, new string, d *schema.ResourceData) bool {
+	var oldProperties, newProperties interface{}
+
+	if err := json.Unmarshal([]byte(old), &oldProperties); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newProperties); err != nil {
+		return false
+	}
+
+	return equivalentJSON(oldProperties, newProperties)
+}
+
+func equivalentJSON(actual, expected interface{}) bool {
+	actualMap, actualOk := actual.(map[string]interface{})
+	expectedMap, expectedOk := expected.(map[string]interface{})
+	if actualOk && expectedOk {
+		for key, value := range actualMap {
+			expectedValue, ok := expectedMap[key]
+			if !ok {
+				if !isZeroJSON(value) {
+					return false
+				}
+				continue
+			}
+			if !equivalentJSON(value, expectedValue) {
+				return false
+			}
+		}
+		for key, value := range expectedMap {
+			if _, ok := actualMap[key]; !ok && !isZeroJSON(value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	actualList, actualOk := actual.([]interface{})
+	expectedList, expectedOk := expected.([]interface{})
+	if actualOk && expectedOk {
+		if len(actualList) != len(expectedList) {
+			return false
+		}
+		for i := range actualList {
+			if !equivalentJSON(actualList[i], expectedList[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(actual, expected)
+}
+
+func isZeroJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, item := range v {
+			if !isZeroJSON(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
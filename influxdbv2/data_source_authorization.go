@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
@@ -98,13 +97,12 @@ func dataSourceAuthorization() *schema.Resource {
 }
 
 func dataSourceAuthorizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	authClient := client.AuthorizationsAPI()
+	authClient := meta.(*providerMeta).authorizations
 
 	authorizations, err := authClient.GetAuthorizations(ctx)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	var authorization *domain.Authorization = nil
@@ -0,0 +1,75 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+func TestDataSourceAuthorization(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_authorization" "test" {
+  org_id      = local.org_id
+  description = "read all buckets"
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+
+data "influxdbv2_authorization" "test" {
+  id = influxdbv2_authorization.test.id
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdbv2_authorization.test", "token", "influxdbv2_authorization.test", "token"),
+					resource.TestCheckResourceAttr("data.influxdbv2_authorization.test", "description", "read all buckets"),
+					resource.TestCheckResourceAttr("data.influxdbv2_authorization.test", "active", "true"),
+					resource.TestCheckResourceAttr("data.influxdbv2_authorization.test", "org_id", server.OrgID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAuthorization(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_authorization" "test" {
+  org_id      = local.org_id
+  description = "tf-acc-data-source-authorization"
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+
+data "influxdbv2_authorization" "test" {
+  id = influxdbv2_authorization.test.id
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("data.influxdbv2_authorization.test", "description", "influxdbv2_authorization.test", "description"),
+						resource.TestCheckResourceAttr("data.influxdbv2_authorization.test", "org_id", env.orgId),
+					),
+				},
+			},
+		}
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
@@ -79,8 +78,7 @@ func dataSourceBucket() *schema.Resource {
 }
 
 func dataSourceBucketRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	bucketsClient := client.BucketsAPI()
+	bucketsClient := meta.(*providerMeta).buckets
 
 	id, idOk := data.GetOk("id")
 	name, nameOk := data.GetOk("name")
@@ -104,7 +102,7 @@ func dataSourceBucketRead(ctx context.Context, data *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	diags := setBucketData(data, bucket)
@@ -0,0 +1,85 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+func TestDataSourceBucket(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name        = "metrics"
+  org_id      = local.org_id
+  description = "raw metrics"
+}
+
+data "influxdbv2_bucket" "by_id" {
+  id = influxdbv2_bucket.test.id
+}
+
+data "influxdbv2_bucket" "by_name" {
+  name = influxdbv2_bucket.test.name
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdbv2_bucket.by_id", "name", "influxdbv2_bucket.test", "name"),
+					resource.TestCheckResourceAttrPair("data.influxdbv2_bucket.by_id", "description", "influxdbv2_bucket.test", "description"),
+					resource.TestCheckResourceAttrPair("data.influxdbv2_bucket.by_name", "id", "influxdbv2_bucket.test", "id"),
+					resource.TestCheckResourceAttr("data.influxdbv2_bucket.by_name", "org_id", server.OrgID),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceBucketNotFound(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+data "influxdbv2_bucket" "test" {
+  id = "0000000000000001"
+}
+`),
+				ExpectError: regexp.MustCompile("bucket not found"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceBucket(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-data-source-bucket"
+  org_id = local.org_id
+}
+
+data "influxdbv2_bucket" "by_name" {
+  name = influxdbv2_bucket.test.name
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("data.influxdbv2_bucket.by_name", "id", "influxdbv2_bucket.test", "id"),
+						resource.TestCheckResourceAttr("data.influxdbv2_bucket.by_name", "org_id", env.orgId),
+					),
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceHealth() *schema.Resource {
+	return &schema.Resource{
+		Description: "InfluxDB Health data source",
+		ReadContext: dataSourceHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the service.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"message": {
+				Description: "Health message of the service.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Health status. Enum: 'pass'|'fail'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "Version of the server.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"commit": {
+				Description: "Commit the server was built from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceHealthRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instance := meta.(*providerMeta).instance
+
+	health, err := instance.Health(ctx)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	data.Set("name", health.Name)
+	data.Set("message", health.Message)
+	data.Set("status", string(health.Status))
+	data.Set("version", health.Version)
+	data.Set("commit", health.Commit)
+	data.SetId(instance.ServerURL())
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceHealth(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+data "influxdbv2_health" "test" {}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.influxdbv2_health.test", "status", "pass"),
+						resource.TestCheckResourceAttrSet("data.influxdbv2_health.test", "version"),
+					),
+				},
+			},
+		}
+	})
+}
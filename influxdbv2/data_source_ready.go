@@ -0,0 +1,73 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+func dataSourceReady() *schema.Resource {
+	return &schema.Resource{
+		Description: "InfluxDB Ready data source",
+		ReadContext: dataSourceReadyRead,
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Description: "Readiness status. Enum: 'ready'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"started": {
+				Description: "Date the server was started.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"up": {
+				Description: "Uptime of the server.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "Version of the server.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"commit": {
+				Description: "Commit the server was built from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceReadyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instance := meta.(*providerMeta).instance
+
+	ready, err := instance.Ready(ctx)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	// The ready endpoint does not report the build, it is read from the health endpoint.
+	health, err := instance.Health(ctx)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if ready.Status != nil {
+		data.Set("status", string(*ready.Status))
+	}
+	if ready.Started != nil {
+		data.Set("started", ready.Started.Format(time.RFC3339))
+	}
+	data.Set("up", ready.Up)
+	data.Set("version", health.Version)
+	data.Set("commit", health.Commit)
+	data.SetId(instance.ServerURL())
+
+	return nil
+}
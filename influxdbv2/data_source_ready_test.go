@@ -0,0 +1,25 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceReady(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+data "influxdbv2_ready" "test" {}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.influxdbv2_ready.test", "status", "ready"),
+						resource.TestCheckResourceAttrSet("data.influxdbv2_ready.test", "started"),
+					),
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func dataSourceTemplateExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "InfluxDB Template Export data source. Exports the resources of an organization as a template.",
+		ReadContext: dataSourceTemplateExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization to export.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_kinds": {
+				Description: "Kinds of resources to export. All kinds are exported if not set.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(templateKinds, false),
+				},
+			},
+			"label_names": {
+				Description: "Names of labels to export resources by. Only resources with one of the labels are exported.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"json": {
+				Description: "Exported template as JSON.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"yaml": {
+				Description: "Exported template as multi-document YAML.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceTemplateExportRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templatesClient := meta.(*providerMeta).templates
+
+	orgId := data.Get("org_id").(string)
+
+	body, err := json.Marshal(mapToTemplateExport(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := templatesClient.ExportTemplateWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	jsonTemplate, yamlTemplate, err := formatTemplate(response.Body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("json", jsonTemplate)
+	data.Set("yaml", yamlTemplate)
+	data.SetId(orgId)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceTemplateExport(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-template-export"
+  org_id = local.org_id
+}
+
+data "influxdbv2_template_export" "test" {
+  org_id         = influxdbv2_bucket.test.org_id
+  resource_kinds = ["Bucket"]
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestMatchResourceAttr("data.influxdbv2_template_export.test", "json", regexp.MustCompile("tf-acc-template-export")),
+						resource.TestMatchResourceAttr("data.influxdbv2_template_export.test", "yaml", regexp.MustCompile("kind: Bucket")),
+					),
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"strings"
+	"unicode"
+)
+
+func validateDeletePredicate(value interface{}, path cty.Path) diag.Diagnostics {
+	err := parseDeletePredicate(value.(string))
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid delete predicate",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+
+	return nil
+}
+
+// parseDeletePredicate checks the predicate against the delete predicate syntax:
+// comparisons of a tag key to a string value with = or !=, combined with AND and grouped with parentheses.
+func parseDeletePredicate(predicate string) error {
+	tokens, err := tokenizeDeletePredicate(predicate)
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	parser := &deletePredicateParser{tokens: tokens}
+	if err := parser.parseExpression(); err != nil {
+		return err
+	}
+
+	if parser.position < len(parser.tokens) {
+		return fmt.Errorf("unexpected %q", parser.tokens[parser.position].value)
+	}
+
+	return nil
+}
+
+type deletePredicateTokenKind int
+
+const (
+	predicateIdentifier deletePredicateTokenKind = iota
+	predicateString
+	predicateOperator
+	predicateAnd
+	predicateLeftParen
+	predicateRightParen
+)
+
+type deletePredicateToken struct {
+	kind  deletePredicateTokenKind
+	value string
+}
+
+func tokenizeDeletePredicate(predicate string) ([]deletePredicateToken, error) {
+	var tokens []deletePredicateToken
+	runes := []rune(predicate)
+
+	for i := 0; i < len(runes); {
+		char := runes[i]
+		switch {
+		case unicode.IsSpace(char):
+			i++
+		case char == '(':
+			tokens = append(tokens, deletePredicateToken{predicateLeftParen, "("})
+			i++
+		case char == ')':
+			tokens = append(tokens, deletePredicateToken{predicateRightParen, ")"})
+			i++
+		case char == '=':
+			tokens = append(tokens, deletePredicateToken{predicateOperator, "="})
+			i++
+		case char == '!':
+			if i+1 >= len(runes) || runes[i+1] != '=' {
+				return nil, fmt.Errorf("unexpected %q, only = and != comparisons are supported", string(char))
+			}
+			tokens = append(tokens, deletePredicateToken{predicateOperator, "!="})
+			i += 2
+		case char == '"' || char == '\'':
+			var value strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != char; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				value.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", i)
+			}
+			tokens = append(tokens, deletePredicateToken{predicateString, value.String()})
+			i = j + 1
+		case isPredicateIdentifierRune(char):
+			j := i
+			for j < len(runes) && isPredicateIdentifierRune(runes[j]) {
+				j++
+			}
+			word := string(runes[i:j])
+			switch strings.ToUpper(word) {
+			case "AND":
+				tokens = append(tokens, deletePredicateToken{predicateAnd, word})
+			case "OR":
+				return nil, fmt.Errorf("OR is not supported in delete predicates")
+			default:
+				tokens = append(tokens, deletePredicateToken{predicateIdentifier, word})
+			}
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", string(char), i)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isPredicateIdentifierRune(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char) || char == '_' || char == '-' || char == '.'
+}
+
+type deletePredicateParser struct {
+	tokens   []deletePredicateToken
+	position int
+}
+
+func (p *deletePredicateParser) next() (deletePredicateToken, bool) {
+	if p.position >= len(p.tokens) {
+		return deletePredicateToken{}, false
+	}
+	token := p.tokens[p.position]
+	p.position++
+	return token, true
+}
+
+func (p *deletePredicateParser) parseExpression() error {
+	if err := p.parseTerm(); err != nil {
+		return err
+	}
+
+	for p.position < len(p.tokens) && p.tokens[p.position].kind == predicateAnd {
+		p.position++
+		if err := p.parseTerm(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *deletePredicateParser) parseTerm() error {
+	token, ok := p.next()
+	if !ok {
+		return fmt.Errorf("unexpected end of predicate")
+	}
+
+	if token.kind == predicateLeftParen {
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+		closing, ok := p.next()
+		if !ok || closing.kind != predicateRightParen {
+			return fmt.Errorf("missing closing parenthesis")
+		}
+		return nil
+	}
+
+	if token.kind != predicateIdentifier && token.kind != predicateString {
+		return fmt.Errorf("expected a tag key, got %q", token.value)
+	}
+	if token.value == "_field" {
+		return fmt.Errorf("deleting by _field is not supported")
+	}
+
+	operator, ok := p.next()
+	if !ok || operator.kind != predicateOperator {
+		return fmt.Errorf("expected = or != after %q", token.value)
+	}
+
+	value, ok := p.next()
+	if !ok || value.kind != predicateString {
+		return fmt.Errorf("expected a quoted string value after %s%s", token.value, operator.value)
+	}
+
+	return nil
+}
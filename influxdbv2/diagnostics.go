@@ -0,0 +1,97 @@
+package influxdbv2
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	ihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"net/http"
+)
+
+// Hints for the error codes of the InfluxDB API, explaining common causes and fixes.
+var apiErrorHints = map[string]string{
+	"unauthorized":         "The token of the provider is invalid or inactive, or its authorization lacks a permission for this operation. Check the token of the provider and grant the missing permission, e.g. write access to the buckets of the organization.",
+	"forbidden":            "The authorization of the provider token lacks a permission for this operation. Grant the missing permission, or use an all-access token.",
+	"not found":            "The resource, or a resource it refers to such as its organization, does not exist or the provider token is not allowed to read it. Check the IDs in the configuration and the read permissions of the token.",
+	"conflict":             "A resource with the same name already exists. Choose another name, or import the existing resource with `terraform import`.",
+	"invalid":              "InfluxDB rejected a value of the configuration, see the message above.",
+	"unprocessable entity": "InfluxDB rejected a value of the configuration, see the message above.",
+	"empty value":          "A required value is empty.",
+	"too many requests":    "InfluxDB rate limits the requests. Retry later, or lower the number of concurrent operations with `terraform apply -parallelism`.",
+	"unavailable":          "InfluxDB is temporarily unavailable. Retry later, or set `wait_for_ready` in the provider configuration to wait until it is ready.",
+	"request too large":    "The request exceeds the size limit of InfluxDB, e.g. too many points are written at once.",
+}
+
+// Error codes of HTTP statuses, for error responses without an error code.
+var apiErrorStatusCodes = map[int]string{
+	http.StatusBadRequest:            "invalid",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not found",
+	http.StatusConflict:              "conflict",
+	http.StatusRequestEntityTooLarge: "request too large",
+	http.StatusUnprocessableEntity:   "unprocessable entity",
+	http.StatusTooManyRequests:       "too many requests",
+	http.StatusServiceUnavailable:    "unavailable",
+}
+
+// apiError returns the error response of the InfluxDB API in the error chain, or nil for other errors such as
+// connection failures.
+func apiError(err error) *ihttp.Error {
+	var httpError *ihttp.Error
+	if errors.As(err, &httpError) && httpError.StatusCode != 0 {
+		return httpError
+	}
+
+	return nil
+}
+
+// apiErrorCode returns the InfluxDB error code of the response, derived from the HTTP status if the response has none.
+func apiErrorCode(apiErr *ihttp.Error) string {
+	if _, ok := apiErrorHints[apiErr.Code]; ok {
+		return apiErr.Code
+	}
+
+	if code, ok := apiErrorStatusCodes[apiErr.StatusCode]; ok {
+		return code
+	}
+
+	return apiErr.Code
+}
+
+// apiErrorDetail describes the error response with its error code and HTTP status, followed by a hint to fix it.
+func apiErrorDetail(apiErr *ihttp.Error) string {
+	code := apiErrorCode(apiErr)
+	detail := fmt.Sprintf("InfluxDB error code %q, HTTP status %d %s.", code, apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+
+	if hint, ok := apiErrorHints[code]; ok {
+		detail += "\n\n" + hint
+	}
+
+	return detail
+}
+
+// apiErrorDiagnostics converts an error of the InfluxDB API to diagnostics with its error code, HTTP status and a hint.
+// Other errors are converted as diag.FromErr does.
+func apiErrorDiagnostics(err error) diag.Diagnostics {
+	return apiErrorAttributeDiagnostics(err, nil)
+}
+
+// apiErrorAttributeDiagnostics converts an error of the InfluxDB API like apiErrorDiagnostics, pointing at the attribute
+// the error is about.
+func apiErrorAttributeDiagnostics(err error, attributePath cty.Path) diag.Diagnostics {
+	apiErr := apiError(err)
+	if apiErr == nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       err.Error(),
+			Detail:        apiErrorDetail(apiErr),
+			AttributePath: attributePath,
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package influxdbv2
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	ihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		detail []string
+	}{
+		{
+			"conflict",
+			&ihttp.Error{StatusCode: 422, Code: "conflict", Message: "bucket with name metrics already exists"},
+			[]string{`error code "conflict", HTTP status 422`, "terraform import"},
+		},
+		{
+			"wrapped unauthorized",
+			fmt.Errorf("creating bucket: %w", &ihttp.Error{StatusCode: 401, Code: "unauthorized", Message: "unauthorized access"}),
+			[]string{`error code "unauthorized", HTTP status 401 Unauthorized`, "missing permission"},
+		},
+		{
+			"status without code",
+			&ihttp.Error{StatusCode: 404, Code: "404 Not Found", Message: "page not found"},
+			[]string{`error code "not found", HTTP status 404 Not Found`, "does not exist"},
+		},
+		{
+			"unknown code",
+			&ihttp.Error{StatusCode: 500, Code: "internal error", Message: "boom"},
+			[]string{`error code "internal error", HTTP status 500 Internal Server Error.`},
+		},
+	}
+
+	for _, test := range tests {
+		diags := apiErrorDiagnostics(test.err)
+		if len(diags) != 1 || diags[0].Severity != diag.Error {
+			t.Fatalf("%s: expected one error, got %v", test.name, diags)
+		}
+
+		if diags[0].Summary != test.err.Error() {
+			t.Errorf("%s: expected summary %q, got %q", test.name, test.err.Error(), diags[0].Summary)
+		}
+
+		for _, detail := range test.detail {
+			if !strings.Contains(diags[0].Detail, detail) {
+				t.Errorf("%s: expected detail containing %q, got %q", test.name, detail, diags[0].Detail)
+			}
+		}
+	}
+}
+
+func TestAPIErrorDiagnosticsOtherErrors(t *testing.T) {
+	err := errors.New("connection refused")
+
+	diags := apiErrorDiagnostics(err)
+	if len(diags) != 1 || diags[0].Summary != err.Error() || diags[0].Detail != "" {
+		t.Errorf("expected the error as is, got %v", diags)
+	}
+}
+
+func TestAPIErrorAttributeDiagnostics(t *testing.T) {
+	err := &ihttp.Error{StatusCode: 400, Code: "invalid", Message: "invalid predicate"}
+
+	diags := apiErrorAttributeDiagnostics(err, cty.GetAttrPath("predicate"))
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("predicate")) {
+		t.Errorf("expected an error for predicate, got %v", diags)
+	}
+}
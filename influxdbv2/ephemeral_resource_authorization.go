@@ -0,0 +1,168 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Private key holding the ID of the authorization to delete on close.
+const privateAuthorizationId = "authorization_id"
+
+// authorizationEphemeralResource creates an authorization for the duration of a Terraform run, so its token is
+// never stored in the state.
+type authorizationEphemeralResource struct {
+	meta *providerMeta
+}
+
+type authorizationEphemeralResourceModel struct {
+	Id          types.String      `tfsdk:"id"`
+	OrgId       types.String      `tfsdk:"org_id"`
+	Permissions []permissionModel `tfsdk:"permissions"`
+	Description types.String      `tfsdk:"description"`
+	UserId      types.String      `tfsdk:"user_id"`
+	Token       types.String      `tfsdk:"token"`
+}
+
+func newAuthorizationEphemeralResource() ephemeral.EphemeralResource {
+	return &authorizationEphemeralResource{}
+}
+
+func (r *authorizationEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization"
+}
+
+func (r *authorizationEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "InfluxDB Authorization ephemeral resource. The authorization is created when Terraform opens the resource and deleted when Terraform closes it, so its token is never stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization that the authorization is scoped to.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the token.",
+				Optional:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user that created and owns the token.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token used to authenticate API requests.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permissions": schema.SetNestedBlock{
+				MarkdownDescription: permissionsDescription,
+				Validators:          permissionsValidators,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: permissionEphemeralSchemaAttributes(permissionAttributes),
+					Blocks: map[string]schema.Block{
+						"resource": schema.SetNestedBlock{
+							MarkdownDescription: permissionResourceDescription,
+							Validators:          permissionResourceValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: permissionEphemeralSchemaAttributes(permissionResourceAttributes),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// permissionEphemeralSchemaAttributes is the ephemeral counterpart of permissionSchemaAttributes.
+func permissionEphemeralSchemaAttributes(attributes map[string]permissionAttribute) map[string]schema.Attribute {
+	schemaAttributes := map[string]schema.Attribute{}
+	for name, attribute := range attributes {
+		schemaAttributes[name] = schema.StringAttribute{
+			MarkdownDescription: attribute.description,
+			Required:            attribute.required,
+			Optional:            !attribute.required,
+			Validators:          attribute.validators,
+		}
+	}
+	return schemaAttributes
+}
+
+func (r *authorizationEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.meta = configureMeta(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *authorizationEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
+
+	var config authorizationEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := config.OrgId.ValueString()
+	permissions := mapModelToPermissions(config.Permissions)
+
+	authorization := &domain.Authorization{
+		OrgID:       &orgId,
+		Permissions: &permissions,
+		UserID:      config.UserId.ValueStringPointer(),
+	}
+
+	status := domain.AuthorizationUpdateRequestStatusActive
+	authorization.Status = &status
+	authorization.Description = config.Description.ValueStringPointer()
+
+	authorization, err := r.meta.authorizations.CreateAuthorization(ctx, authorization)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to create authorization", err, authorizationErrorPath(err))
+		return
+	}
+
+	// Private state values are JSON.
+	privateId, _ := json.Marshal(*authorization.Id)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateAuthorizationId, privateId)...)
+
+	config.Id = types.StringPointerValue(authorization.Id)
+	config.UserId = types.StringValue(stringValue(authorization.UserID))
+	config.Token = types.StringValue(stringValue(authorization.Token))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+func (r *authorizationEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
+
+	privateId, diags := req.Private.GetKey(ctx, privateAuthorizationId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateId == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(privateId, &id); err != nil {
+		resp.Diagnostics.AddError("Unable to read the authorization ID", err.Error())
+		return
+	}
+
+	err := r.meta.authorizations.DeleteAuthorizationWithID(ctx, id)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to delete authorization", err, path.Empty())
+	}
+}
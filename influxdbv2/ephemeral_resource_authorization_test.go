@@ -0,0 +1,68 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+func TestEphemeralResourceAuthorization(t *testing.T) {
+	testSkipBelowTerraform(t, "1.10.0")
+
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, fmt.Sprintf(`
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = local.org_id
+}
+
+ephemeral "influxdbv2_authorization" "ci" {
+  org_id      = local.org_id
+  description = "ci"
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+
+provider "influxdbv2" {
+  alias = "ci"
+  host  = %q
+  token = ephemeral.influxdbv2_authorization.ci.token
+}
+
+data "influxdbv2_bucket" "ci" {
+  provider = influxdbv2.ci
+  name     = influxdbv2_bucket.test.name
+}
+`, server.URL)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdbv2_bucket.ci", "id", "influxdbv2_bucket.test", "id"),
+					testCheckEphemeralAuthorizationsClosed(server),
+				),
+			},
+		},
+	})
+}
+
+// testCheckEphemeralAuthorizationsClosed checks that only the authorization of the onboarded user is left on the server.
+func testCheckEphemeralAuthorizationsClosed(server *influxdbtest.Server) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, authorization := range server.Authorizations() {
+			if *authorization.Token != server.Token {
+				return fmt.Errorf("authorization %s was not deleted on close", *authorization.Id)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"math"
+	"strconv"
+)
+
+// Duration units accepted by duration_to_seconds, in seconds. Months and years have no fixed length and are left out.
+var durationUnits = map[string]int64{
+	"w": 7 * 24 * 60 * 60,
+	"d": 24 * 60 * 60,
+	"h": 60 * 60,
+	"m": 60,
+	"s": 1,
+}
+
+type durationToSecondsFunction struct{}
+
+func newDurationToSecondsFunction() function.Function {
+	return &durationToSecondsFunction{}
+}
+
+func (f *durationToSecondsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_to_seconds"
+}
+
+func (f *durationToSecondsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts an InfluxDB duration to seconds",
+		MarkdownDescription: "Converts an InfluxDB duration literal like `30d` or `1h30m` to seconds, e.g. for `every_seconds` of bucket retention rules. Supported units are `w`, `d`, `h`, `m` and `s`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "Duration literal, e.g. `30d`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *durationToSecondsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var duration string
+	resp.Error = req.Arguments.Get(ctx, &duration)
+	if resp.Error != nil {
+		return
+	}
+
+	seconds, err := parseDurationSeconds(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, seconds)
+}
+
+// parseDurationSeconds parses a sequence of integers followed by a unit, like the duration literals of Flux.
+func parseDurationSeconds(duration string) (int64, error) {
+	if duration == "" {
+		return 0, fmt.Errorf("duration must not be empty")
+	}
+
+	var seconds int64
+	for rest := duration; rest != ""; {
+		digits := 0
+		for digits < len(rest) && rest[digits] >= '0' && rest[digits] <= '9' {
+			digits++
+		}
+		letters := digits
+		for letters < len(rest) && (rest[letters] < '0' || rest[letters] > '9') {
+			letters++
+		}
+
+		if digits == 0 {
+			return 0, fmt.Errorf("invalid duration %q: expected a number before %q", duration, rest)
+		}
+
+		unitSeconds, ok := durationUnits[rest[digits:letters]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration %q: unit %q is not one of w, d, h, m, s", duration, rest[digits:letters])
+		}
+
+		value, err := strconv.ParseInt(rest[:digits], 10, 64)
+		if err != nil || value > (math.MaxInt64-seconds)/unitSeconds {
+			return 0, fmt.Errorf("invalid duration %q: value out of range", duration)
+		}
+
+		seconds += value * unitSeconds
+		rest = rest[letters:]
+	}
+
+	return seconds, nil
+}
@@ -0,0 +1,34 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestDurationToSecondsFunction(t *testing.T) {
+	for duration, expected := range map[string]int64{
+		"30d":    2592000,
+		"1w":     604800,
+		"1h30m":  5400,
+		"90s":    90,
+		"1d1h1s": 90001,
+		"0s":     0,
+	} {
+		result, funcErr := testRunFunction(t, newDurationToSecondsFunction(), types.StringValue(duration))
+		if funcErr != nil {
+			t.Errorf("%s: unexpected error: %s", duration, funcErr)
+			continue
+		}
+		if !result.Equal(types.Int64Value(expected)) {
+			t.Errorf("%s: expected %d, got %s", duration, expected, result)
+		}
+	}
+}
+
+func TestDurationToSecondsFunctionInvalid(t *testing.T) {
+	for _, duration := range []string{"", "30", "d", "1mo", "1y", "5ms", "1.5h", "-1h", "99999999999999999999s", "1000000000000000w"} {
+		if _, funcErr := testRunFunction(t, newDurationToSecondsFunction(), types.StringValue(duration)); funcErr == nil {
+			t.Errorf("%q: expected an error", duration)
+		}
+	}
+}
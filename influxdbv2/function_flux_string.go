@@ -0,0 +1,44 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"strings"
+)
+
+// fluxStringEscaper escapes the characters with a meaning in Flux string literals.
+var fluxStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `${`, `\${`)
+
+type fluxStringFunction struct{}
+
+func newFluxStringFunction() function.Function {
+	return &fluxStringFunction{}
+}
+
+func (f *fluxStringFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "flux_string"
+}
+
+func (f *fluxStringFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Quotes a value as a Flux string literal",
+		MarkdownDescription: "Quotes a value as a Flux string literal, escaping backslashes, double quotes and string interpolation, so it can be inserted into Flux queries and tasks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Value to quote.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *fluxStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, `"`+fluxStringEscaper.Replace(value)+`"`)
+}
@@ -0,0 +1,30 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestFluxStringFunction(t *testing.T) {
+	for value, expected := range map[string]string{
+		"metrics":         `"metrics"`,
+		"":                `""`,
+		`say "hi"`:        `"say \"hi\""`,
+		`C:\data`:         `"C:\\data"`,
+		"${secret}":       `"\${secret}"`,
+		"$ {x} $x":        `"$ {x} $x"`,
+		"line\nbreak":     "\"line\nbreak\"",
+		`\"${`:            `"\\\"\${"`,
+		"tab\tseparated":  "\"tab\tseparated\"",
+		"unicode ąčęėįšų": `"unicode ąčęėįšų"`,
+	} {
+		result, funcErr := testRunFunction(t, newFluxStringFunction(), types.StringValue(value))
+		if funcErr != nil {
+			t.Errorf("%q: unexpected error: %s", value, funcErr)
+			continue
+		}
+		if !result.Equal(types.StringValue(expected)) {
+			t.Errorf("%q: expected %s, got %s", value, expected, result)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Escapers of the line protocol elements, see https://docs.influxdata.com/influxdb/v2/reference/syntax/line-protocol/#special-characters.
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `)
+	keyEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+	fieldStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+type lineProtocolFunction struct{}
+
+func newLineProtocolFunction() function.Function {
+	return &lineProtocolFunction{}
+}
+
+func (f *lineProtocolFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "line_protocol"
+}
+
+func (f *lineProtocolFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a line protocol record",
+		MarkdownDescription: "Builds a line protocol record without timestamp from a measurement, tags and fields, escaping special characters. Field values use the syntax of the `point` block of `influxdbv2_points`: `\"\\\"text\\\"\"` for strings, `42i` for integers, `42u` for unsigned integers, `true` or `false` for booleans and anything else for floats.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "measurement",
+				MarkdownDescription: "Measurement name.",
+			},
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Tag set, may be empty.",
+				ElementType:         types.StringType,
+			},
+			function.MapParameter{
+				Name:                "fields",
+				MarkdownDescription: "Field set, at least one field is required.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *lineProtocolFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var measurement string
+	var tags, fields map[string]string
+	resp.Error = req.Arguments.Get(ctx, &measurement, &tags, &fields)
+	if resp.Error != nil {
+		return
+	}
+
+	if measurement == "" {
+		resp.Error = function.NewArgumentFuncError(0, "measurement must not be empty")
+		return
+	}
+
+	var record strings.Builder
+	record.WriteString(measurementEscaper.Replace(measurement))
+
+	for _, key := range sortedKeys(tags) {
+		if key == "" || tags[key] == "" {
+			resp.Error = function.NewArgumentFuncError(1, "tag keys and values must not be empty")
+			return
+		}
+		record.WriteString("," + keyEscaper.Replace(key) + "=" + keyEscaper.Replace(tags[key]))
+	}
+
+	if len(fields) == 0 {
+		resp.Error = function.NewArgumentFuncError(2, "at least one field is required")
+		return
+	}
+
+	for i, key := range sortedKeys(fields) {
+		value, err := formatFieldValue(fields[key])
+		if key == "" {
+			err = fmt.Errorf("field keys must not be empty")
+		}
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid value of field %q: %s", key, err))
+			return
+		}
+
+		if i == 0 {
+			record.WriteString(" ")
+		} else {
+			record.WriteString(",")
+		}
+		record.WriteString(keyEscaper.Replace(key) + "=" + value)
+	}
+
+	resp.Error = resp.Result.Set(ctx, record.String())
+}
+
+// formatFieldValue parses the field value like the point block of influxdbv2_points and formats it as line protocol.
+func formatFieldValue(value string) (string, error) {
+	parsed, err := parseFieldValue(value)
+	if err != nil {
+		return "", err
+	}
+
+	switch parsed := parsed.(type) {
+	case string:
+		return `"` + fieldStringEscaper.Replace(parsed) + `"`, nil
+	case int64:
+		return strconv.FormatInt(parsed, 10) + "i", nil
+	case uint64:
+		return strconv.FormatUint(parsed, 10) + "u", nil
+	case bool:
+		return strconv.FormatBool(parsed), nil
+	case float64:
+		if math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+			return "", fmt.Errorf("%s is not a finite number", value)
+		}
+		return strconv.FormatFloat(parsed, 'g', -1, 64), nil
+	}
+
+	return "", fmt.Errorf("unsupported value %s", value)
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,70 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func testStringMap(values map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestLineProtocolFunction(t *testing.T) {
+	for _, test := range []struct {
+		measurement string
+		tags        map[string]string
+		fields      map[string]string
+		expected    string
+	}{
+		{
+			measurement: "site",
+			tags:        map[string]string{"site": "vilnius", "region": "eu"},
+			fields:      map[string]string{"name": `"Vilnius DC"`, "capacity": "42i", "active": "true", "load": "0.5"},
+			expected:    `site,region=eu,site=vilnius active=true,capacity=42i,load=0.5,name="Vilnius DC"`,
+		},
+		{
+			measurement: "slo",
+			tags:        map[string]string{},
+			fields:      map[string]string{"target": "99.9"},
+			expected:    `slo target=99.9`,
+		},
+		{
+			measurement: "my measurement,1",
+			tags:        map[string]string{"tag key": "a=b,c"},
+			fields:      map[string]string{"field=key": `"C:\data"`, "count": "7u", "up": "F"},
+			expected:    `my\ measurement\,1,tag\ key=a\=b\,c count=7u,field\=key="C:\\data",up=false`,
+		},
+	} {
+		result, funcErr := testRunFunction(t, newLineProtocolFunction(), types.StringValue(test.measurement), testStringMap(test.tags), testStringMap(test.fields))
+		if funcErr != nil {
+			t.Errorf("%s: unexpected error: %s", test.measurement, funcErr)
+			continue
+		}
+		if !result.Equal(types.StringValue(test.expected)) {
+			t.Errorf("%s: expected %s, got %s", test.measurement, test.expected, result)
+		}
+	}
+}
+
+func TestLineProtocolFunctionInvalid(t *testing.T) {
+	for name, test := range map[string]struct {
+		measurement string
+		tags        map[string]string
+		fields      map[string]string
+	}{
+		"empty measurement": {"", map[string]string{}, map[string]string{"value": "1"}},
+		"empty tag value":   {"m", map[string]string{"host": ""}, map[string]string{"value": "1"}},
+		"no fields":         {"m", map[string]string{}, map[string]string{}},
+		"invalid field":     {"m", map[string]string{}, map[string]string{"value": "one"}},
+		"infinite field":    {"m", map[string]string{}, map[string]string{"value": "Inf"}},
+	} {
+		if _, funcErr := testRunFunction(t, newLineProtocolFunction(), types.StringValue(test.measurement), testStringMap(test.tags), testStringMap(test.fields)); funcErr == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// permissionSetType is the type of the permissions block of influxdbv2_authorization, with lists instead of sets.
+var permissionSetType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"action": types.StringType,
+		"resource": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"type":   types.StringType,
+					"id":     types.StringType,
+					"org_id": types.StringType,
+				},
+			},
+		},
+	},
+}
+
+type permissionSetFunction struct{}
+
+func newPermissionSetFunction() function.Function {
+	return &permissionSetFunction{}
+}
+
+func (f *permissionSetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "permission_set"
+}
+
+func (f *permissionSetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds bucket permissions of an authorization",
+		MarkdownDescription: "Builds one permission per bucket with the given action, in the structure of the `permissions` blocks of `influxdbv2_authorization`, to be used in `dynamic` blocks.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "bucket_ids",
+				MarkdownDescription: "IDs of the buckets.",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Enum: 'read'|'write'.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: permissionSetType,
+		},
+	}
+}
+
+func (f *permissionSetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucketIds []string
+	var action string
+	resp.Error = req.Arguments.Get(ctx, &bucketIds, &action)
+	if resp.Error != nil {
+		return
+	}
+
+	switch domain.PermissionAction(action) {
+	case domain.PermissionActionRead, domain.PermissionActionWrite:
+	default:
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("action must be read or write, got %q", action))
+		return
+	}
+
+	permissions := []permissionModel{}
+	for _, bucketId := range bucketIds {
+		if bucketId == "" {
+			resp.Error = function.NewArgumentFuncError(0, "bucket IDs must not be empty")
+			return
+		}
+
+		permissions = append(permissions, permissionModel{
+			Action: types.StringValue(action),
+			Resource: []permissionResourceModel{
+				{
+					Type:  types.StringValue(string(domain.ResourceTypeBuckets)),
+					Id:    types.StringValue(bucketId),
+					OrgId: types.StringNull(),
+				},
+			},
+		})
+	}
+
+	resp.Error = resp.Result.Set(ctx, permissions)
+}
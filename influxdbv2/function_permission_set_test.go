@@ -0,0 +1,118 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"reflect"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+func TestPermissionSetFunction(t *testing.T) {
+	bucketIds := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("0a1b2c3d4e5f0001"), types.StringValue("0a1b2c3d4e5f0002")})
+
+	result, funcErr := testRunFunction(t, newPermissionSetFunction(), bucketIds, types.StringValue("write"))
+	if funcErr != nil {
+		t.Fatal(funcErr)
+	}
+
+	// The permissions map to the API like the permissions blocks of influxdbv2_authorization.
+	var models []permissionModel
+	if diags := result.(types.List).ElementsAs(context.Background(), &models, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	firstId, secondId := "0a1b2c3d4e5f0001", "0a1b2c3d4e5f0002"
+	expected := []domain.Permission{
+		{Action: domain.PermissionActionWrite, Resource: domain.Resource{Type: domain.ResourceTypeBuckets, Id: &firstId}},
+		{Action: domain.PermissionActionWrite, Resource: domain.Resource{Type: domain.ResourceTypeBuckets, Id: &secondId}},
+	}
+	if actual := mapModelToPermissions(models); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestPermissionSetFunctionInvalid(t *testing.T) {
+	bucketIds := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("0a1b2c3d4e5f0001")})
+	if _, funcErr := testRunFunction(t, newPermissionSetFunction(), bucketIds, types.StringValue("delete")); funcErr == nil {
+		t.Error("expected an error for an invalid action")
+	}
+
+	emptyId := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("")})
+	if _, funcErr := testRunFunction(t, newPermissionSetFunction(), emptyId, types.StringValue("read")); funcErr == nil {
+		t.Error("expected an error for an empty bucket ID")
+	}
+}
+
+func TestPermissionSetFunctionInAuthorization(t *testing.T) {
+	testSkipBelowTerraform(t, "1.8.0")
+
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+# Provider functions are only available to providers declared in required_providers.
+terraform {
+  required_providers {
+    influxdbv2 = {
+      source = "hashicorp/influxdbv2"
+    }
+  }
+}
+
+resource "influxdbv2_bucket" "metrics" {
+  name   = "metrics"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_bucket" "events" {
+  name   = "events"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_authorization" "test" {
+  org_id = local.org_id
+  dynamic "permissions" {
+    for_each = provider::influxdbv2::permission_set([influxdbv2_bucket.metrics.id, influxdbv2_bucket.events.id], "write")
+    content {
+      action = permissions.value.action
+      dynamic "resource" {
+        for_each = permissions.value.resource
+        content {
+          type   = resource.value.type
+          id     = resource.value.id
+          org_id = resource.value.org_id
+        }
+      }
+    }
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "permissions.#", "2"),
+					func(state *terraform.State) error {
+						id := state.RootModule().Resources["influxdbv2_authorization.test"].Primary.ID
+						authorization, ok := server.Authorization(id)
+						if !ok {
+							return fmt.Errorf("authorization %s not found", id)
+						}
+						for _, permission := range *authorization.Permissions {
+							if permission.Action != domain.PermissionActionWrite || permission.Resource.Type != domain.ResourceTypeBuckets || permission.Resource.Id == nil {
+								return fmt.Errorf("unexpected permission %+v", permission)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
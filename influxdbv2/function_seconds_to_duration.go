@@ -0,0 +1,63 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"strconv"
+	"strings"
+)
+
+type secondsToDurationFunction struct{}
+
+func newSecondsToDurationFunction() function.Function {
+	return &secondsToDurationFunction{}
+}
+
+func (f *secondsToDurationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "seconds_to_duration"
+}
+
+func (f *secondsToDurationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts seconds to an InfluxDB duration",
+		MarkdownDescription: "Converts seconds to the shortest InfluxDB duration literal in days, hours, minutes and seconds, e.g. `2592000` to `30d`. It is the inverse of `duration_to_seconds`.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "seconds",
+				MarkdownDescription: "Number of seconds, 0 or more.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *secondsToDurationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seconds int64
+	resp.Error = req.Arguments.Get(ctx, &seconds)
+	if resp.Error != nil {
+		return
+	}
+
+	if seconds < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "seconds must not be negative")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, formatDurationSeconds(seconds))
+}
+
+func formatDurationSeconds(seconds int64) string {
+	if seconds == 0 {
+		return "0s"
+	}
+
+	var duration strings.Builder
+	for _, unit := range []string{"d", "h", "m", "s"} {
+		if value := seconds / durationUnits[unit]; value > 0 {
+			duration.WriteString(strconv.FormatInt(value, 10) + unit)
+			seconds -= value * durationUnits[unit]
+		}
+	}
+
+	return duration.String()
+}
@@ -0,0 +1,37 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestSecondsToDurationFunction(t *testing.T) {
+	for seconds, expected := range map[int64]string{
+		2592000: "30d",
+		604800:  "7d",
+		5400:    "1h30m",
+		90001:   "1d1h1s",
+		59:      "59s",
+		0:       "0s",
+	} {
+		result, funcErr := testRunFunction(t, newSecondsToDurationFunction(), types.Int64Value(seconds))
+		if funcErr != nil {
+			t.Errorf("%d: unexpected error: %s", seconds, funcErr)
+			continue
+		}
+		if !result.Equal(types.StringValue(expected)) {
+			t.Errorf("%d: expected %s, got %s", seconds, expected, result)
+		}
+
+		// The duration converts back to the same number of seconds.
+		if roundTrip, _ := parseDurationSeconds(expected); roundTrip != seconds {
+			t.Errorf("%d: %s converts back to %d", seconds, expected, roundTrip)
+		}
+	}
+}
+
+func TestSecondsToDurationFunctionNegative(t *testing.T) {
+	if _, funcErr := testRunFunction(t, newSecondsToDurationFunction(), types.Int64Value(-1)); funcErr == nil {
+		t.Error("expected an error")
+	}
+}
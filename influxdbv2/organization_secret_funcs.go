@@ -0,0 +1,7 @@
+package influxdbv2
+
+// discardSecretValue keeps secret values out of the state. Neither the value nor a value derived from it is stored, a
+// hash of a weak secret could be reversed by anyone reading the state.
+func discardSecretValue(value interface{}) string {
+	return ""
+}
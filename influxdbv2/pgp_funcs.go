@@ -0,0 +1,65 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"strings"
+)
+
+// encryptWithPGPKey encrypts the value for the given public key, which is either ASCII-armored or base64-encoded.
+// It returns the fingerprint of the key and the base64-encoded encrypted value.
+func encryptWithPGPKey(key string, value string) (string, string, error) {
+	entity, err := readPGPKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	var encrypted bytes.Buffer
+	writer, err := openpgp.Encrypt(&encrypted, []*openpgp.Entity{entity}, nil, nil, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	if _, err := writer.Write([]byte(value)); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	fingerprint := hex.EncodeToString(entity.PrimaryKey.Fingerprint)
+
+	return fingerprint, base64.StdEncoding.EncodeToString(encrypted.Bytes()), nil
+}
+
+func readPGPKey(key string) (*openpgp.Entity, error) {
+	key = strings.TrimSpace(key)
+
+	var entities openpgp.EntityList
+	var err error
+
+	if strings.HasPrefix(key, "-----BEGIN PGP PUBLIC KEY BLOCK-----") {
+		entities, err = openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	} else {
+		var decoded []byte
+		decoded, err = base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("PGP key is neither ASCII-armored nor base64-encoded: %w", err)
+		}
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(decoded))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PGP key: %w", err)
+	}
+
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("PGP key must contain exactly one public key, got %d", len(entities))
+	}
+
+	return entities[0], nil
+}
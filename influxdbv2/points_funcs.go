@@ -0,0 +1,200 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var precisions = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+}
+
+// Bounds of the time range InfluxDB is able to store.
+var (
+	minPointTime = time.Unix(0, math.MinInt64+2).UTC()
+	maxPointTime = time.Unix(0, math.MaxInt64-1).UTC()
+)
+
+// pointSeries identifies a series by its measurement and tag set.
+type pointSeries struct {
+	measurement string
+	tags        map[string]string
+}
+
+func splitLineProtocol(lines string) []string {
+	var records []string
+	for _, line := range strings.Split(lines, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		records = append(records, line)
+	}
+	return records
+}
+
+func mapToPoints(data *schema.ResourceData) ([]*write.Point, diag.Diagnostics) {
+	var points []*write.Point
+	for _, pointData := range data.Get("point").([]interface{}) {
+		pointDataMap := pointData.(map[string]interface{})
+
+		point := write.NewPointWithMeasurement(pointDataMap["measurement"].(string))
+
+		for key, value := range pointDataMap["tags"].(map[string]interface{}) {
+			point.AddTag(key, value.(string))
+		}
+
+		for key, value := range pointDataMap["fields"].(map[string]interface{}) {
+			fieldValue, err := parseFieldValue(value.(string))
+			if err != nil {
+				return nil, diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Invalid value of field %q", key),
+						Detail:   err.Error(),
+					},
+				}
+			}
+			point.AddField(key, fieldValue)
+		}
+
+		if timestamp := pointDataMap["timestamp"].(string); timestamp != "" {
+			tmp, err := time.Parse(time.RFC3339, timestamp)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			point.SetTime(tmp)
+		}
+
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+func parseFieldValue(value string) (interface{}, error) {
+	switch {
+	case len(value) >= 2 && strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\""):
+		return strings.ReplaceAll(value[1:len(value)-1], "\\\"", "\""), nil
+	case strings.HasSuffix(value, "i"):
+		return strconv.ParseInt(strings.TrimSuffix(value, "i"), 10, 64)
+	case strings.HasSuffix(value, "u"):
+		return strconv.ParseUint(strings.TrimSuffix(value, "u"), 10, 64)
+	}
+
+	switch value {
+	case "t", "T", "true", "True", "TRUE":
+		return true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return false, nil
+	}
+
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseSeries extracts the measurement and tag set from a line protocol record.
+func parseSeries(line string) pointSeries {
+	key := splitUnescaped(line, ' ')[0]
+	parts := splitUnescaped(key, ',')
+
+	series := pointSeries{
+		measurement: unescapeLineProtocol(parts[0]),
+		tags:        map[string]string{},
+	}
+	for _, tag := range parts[1:] {
+		pair := splitUnescaped(tag, '=')
+		if len(pair) == 2 {
+			series.tags[unescapeLineProtocol(pair[0])] = unescapeLineProtocol(pair[1])
+		}
+	}
+
+	return series
+}
+
+func splitUnescaped(value string, separator rune) []string {
+	var parts []string
+	start := 0
+	escaped := false
+
+	for i, char := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case char == '\\':
+			escaped = true
+		case char == separator:
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, value[start:])
+}
+
+func unescapeLineProtocol(value string) string {
+	var unescaped strings.Builder
+	escaped := false
+
+	for _, char := range value {
+		if !escaped && char == '\\' {
+			escaped = true
+			continue
+		}
+		escaped = false
+		unescaped.WriteRune(char)
+	}
+
+	return unescaped.String()
+}
+
+func pointToSeries(point *write.Point) pointSeries {
+	series := pointSeries{
+		measurement: point.Name(),
+		tags:        map[string]string{},
+	}
+	for _, tag := range point.TagList() {
+		series.tags[tag.Key] = tag.Value
+	}
+	return series
+}
+
+// seriesPredicates builds a unique delete predicate for every series.
+func seriesPredicates(series []pointSeries) []string {
+	seen := map[string]bool{}
+	var predicates []string
+
+	for _, s := range series {
+		keys := make([]string, 0, len(s.tags))
+		for key := range s.tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		conditions := []string{"_measurement=" + quotePredicateValue(s.measurement)}
+		for _, key := range keys {
+			conditions = append(conditions, key+"="+quotePredicateValue(s.tags[key]))
+		}
+
+		predicate := strings.Join(conditions, " AND ")
+		if !seen[predicate] {
+			seen[predicate] = true
+			predicates = append(predicates, predicate)
+		}
+	}
+
+	return predicates
+}
+
+func quotePredicateValue(value string) string {
+	return "\"" + strings.ReplaceAll(value, "\"", "\\\"") + "\""
+}
@@ -2,9 +2,15 @@ package influxdbv2
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"terraform-provider-influxdbv2/internal/httplog"
+	"terraform-provider-influxdbv2/internal/recorder"
+	"time"
 )
 
 func init() {
@@ -28,27 +34,61 @@ func New(version string) func() *schema.Provider {
 		p := &schema.Provider{
 			Schema: map[string]*schema.Schema{
 				"host": {
-					Type:     schema.TypeString,
-					Required: true,
+					Description: "URL of the InfluxDB server, e.g. `http://localhost:8086`. The provider connects on the first request, so the URL may refer to a resource created in the same run.",
+					Type:        schema.TypeString,
+					Required:    true,
 				},
 				"token": {
 					Type:      schema.TypeString,
 					Required:  true,
 					Sensitive: true,
 				},
+				"wait_for_ready": {
+					Description:      "Maximum time to wait for the server to become ready before the first request, e.g. `2m`. Readiness is not checked if not set.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateDuration,
+				},
+				"read_only": {
+					Description: "Fail creating, updating and deleting resources before any request to InfluxDB, e.g. to plan against production without the risk of changing it. Refreshing resources and reading data sources keep working. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"influxdbv2_bucket":        dataSourceBucket(),
-				"influxdbv2_authorization": dataSourceAuthorization(),
+				"influxdbv2_bucket":          dataSourceBucket(),
+				"influxdbv2_authorization":   dataSourceAuthorization(),
+				"influxdbv2_template_export": dataSourceTemplateExport(),
+				"influxdbv2_health":          dataSourceHealth(),
+				"influxdbv2_ready":           dataSourceReady(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"influxdbv2_bucket":        resourceBucket(),
-				"influxdbv2_authorization": resourceAuthorization(),
+				"influxdbv2_bucket_token":           resourceBucketToken(),
+				"influxdbv2_authorization_rotating": resourceAuthorizationRotating(),
+				"influxdbv2_points":                 resourcePoints(),
+				"influxdbv2_delete_data":            resourceDeleteData(),
+				"influxdbv2_telegraf_config":        resourceTelegrafConfig(),
+				"influxdbv2_scraper_target":         resourceScraperTarget(),
+				"influxdbv2_dashboard":              resourceDashboard(),
+				"influxdbv2_variable":               resourceVariable(),
+				"influxdbv2_organization_secret":    resourceOrganizationSecret(),
+				"influxdbv2_remote_connection":      resourceRemoteConnection(),
+				"influxdbv2_replication":            resourceReplication(),
+				"influxdbv2_stack":                  resourceStack(),
 			},
 		}
 
 		p.ConfigureContextFunc = configure(version, p)
 
+		for _, resource := range p.ResourcesMap {
+			withConnection(resource)
+			withUnknownConfig(resource)
+			withReadOnly(resource)
+		}
+		for _, dataSource := range p.DataSourcesMap {
+			withConnection(dataSource)
+		}
+
 		return p
 	}
 
@@ -60,11 +100,116 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		// userAgent := p.UserAgent("terraform-provider-scaffolding", version)
 		// TODO: myClient.UserAgent = userAgent
 
-		host := (*data).Get("host").(string)
-		token := (*data).Get("token").(string)
+		return newProviderMeta(providerConfig{
+			host:         (*data).Get("host").(string),
+			token:        (*data).Get("token").(string),
+			waitForReady: (*data).Get("wait_for_ready").(string),
+			readOnly:     (*data).Get("read_only").(bool),
+			unknown:      !data.GetRawConfig().IsWhollyKnown(),
+		}), nil
+	}
+}
+
+// newProviderMeta returns the provider meta for the configuration. The client is created on the first API call, see
+// connect, so the configuration may depend on values known only at apply, e.g. the address of an InfluxDB container
+// created in the same run.
+// The SDK and the framework provider are configured separately and each build their own meta, so a run using
+// resources of both connects twice, waiting for readiness and detecting the server once per provider.
+func newProviderMeta(config providerConfig) *providerMeta {
+	return &providerMeta{config: config}
+}
+
+// connect creates the client for the InfluxDB at the configured host on the first call and waits until the server
+// is ready. Once connected, later calls return at once. A failed attempt is not kept, so the next call tries again,
+// e.g. after the server came up.
+func (m *providerMeta) connect(ctx context.Context) error {
+	m.connectLock.Lock()
+	defer m.connectLock.Unlock()
+
+	if m.connected {
+		return nil
+	}
+
+	if err := m.newClient(ctx); err != nil {
+		return err
+	}
+	m.connected = true
+
+	return nil
+}
+
+func (m *providerMeta) newClient(ctx context.Context) error {
+	if m.config.unknown {
+		return errProviderConfigUnknown
+	}
+
+	options := influxdb2.DefaultOptions()
+	httpClient := options.HTTPClient()
+
+	// Acceptance tests record or replay the traffic of the provider through a cassette.
+	transport, err := recorder.FromEnv()
+	if err != nil {
+		return err
+	}
+	if transport != nil {
+		transport.Wrap(httpClient.Transport)
+		httpClient.Transport = transport
+	}
+
+	// Every request is logged through tflog, e.g. with TF_LOG=DEBUG.
+	httpClient.Transport = httplog.Wrap(httpClient.Transport)
+
+	client := influxdb2.NewClientWithOptions(m.config.host, m.config.token, options)
+
+	if m.config.waitForReady != "" {
+		timeout, _ := time.ParseDuration(m.config.waitForReady)
+		if err := waitForServerReady(ctx, client, timeout); err != nil {
+			return err
+		}
+	}
+
+	m.influxdbAPI = newClientAPI(client)
+	m.server = detectServer(ctx, client)
+
+	return nil
+}
 
-		client := influxdb2.NewClient(host, token)
+// waitForServerReady polls the ready endpoint until the server reports it is ready or the timeout passes.
+func waitForServerReady(ctx context.Context, client instanceAPI, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-		return &client, nil
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		ready, err := client.Ready(ctx)
+		if err == nil && ready.Status != nil && *ready.Status == domain.ReadyStatusReady {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("InfluxDB at %s is not ready after %s: %w", client.ServerURL(), timeout, err)
+			}
+			return fmt.Errorf("InfluxDB at %s is not ready after %s", client.ServerURL(), timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func validateDuration(value interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := time.ParseDuration(value.(string)); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid duration",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
 	}
+
+	return nil
 }
@@ -0,0 +1,255 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"strconv"
+	"time"
+)
+
+// NewMuxServer serves the SDK provider and the framework provider as one provider over protocol version 6.
+func NewMuxServer(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	sdkServer, err := tf5to6server.UpgradeServer(ctx, New(version)().GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return sdkServer },
+		providerserver.NewProtocol6(newFrameworkProvider(version)()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider serves the resources ported to terraform-plugin-framework. It is muxed with the SDK provider
+// returned by New, so both must declare the same provider schema.
+type frameworkProvider struct {
+	version string
+}
+
+type frameworkProviderModel struct {
+	Host         types.String `tfsdk:"host"`
+	Token        types.String `tfsdk:"token"`
+	WaitForReady types.String `tfsdk:"wait_for_ready"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+}
+
+func newFrameworkProvider(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &frameworkProvider{version: version}
+	}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "influxdbv2"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "URL of the InfluxDB server, e.g. `http://localhost:8086`. The provider connects on the first request, so the URL may refer to a resource created in the same run.",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"wait_for_ready": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the server to become ready before the first request, e.g. `2m`. Readiness is not checked if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Fail creating, updating and deleting resources before any request to InfluxDB, e.g. to plan against production without the risk of changing it. Refreshing resources and reading data sources keep working. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	meta := newProviderMeta(providerConfig{
+		host:         config.Host.ValueString(),
+		token:        config.Token.ValueString(),
+		waitForReady: config.WaitForReady.ValueString(),
+		readOnly:     config.ReadOnly.ValueBool(),
+		unknown:      config.Host.IsUnknown() || config.Token.IsUnknown() || config.WaitForReady.IsUnknown() || config.ReadOnly.IsUnknown(),
+	})
+
+	resp.ResourceData = meta
+	resp.DataSourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newBucketResource,
+		newAuthorizationResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newDurationToSecondsFunction,
+		newSecondsToDurationFunction,
+		newFluxStringFunction,
+		newLineProtocolFunction,
+		newPermissionSetFunction,
+	}
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newAuthorizationEphemeralResource,
+	}
+}
+
+// durationValidator checks that a string is a Go duration, like validateDuration of the SDK provider.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration, e.g. 2m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a duration, e.g. `2m`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+	}
+}
+
+// configureMeta returns the provider meta handed to a framework resource, or nil before the provider is configured.
+func configureMeta(providerData any, diags *diag.Diagnostics) *providerMeta {
+	if providerData == nil {
+		return nil
+	}
+
+	meta, ok := providerData.(*providerMeta)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("Expected *providerMeta, got %T.", providerData))
+		return nil
+	}
+
+	return meta
+}
+
+// addAPIError adds a diagnostic for an error of the InfluxDB API, describing its error code and HTTP status like
+// apiErrorDiagnostics does for the SDK provider. An empty attribute path reports the error for the whole resource.
+func addAPIError(diags *diag.Diagnostics, summary string, err error, attributePath path.Path) {
+	detail := err.Error()
+	if apiErr := apiError(err); apiErr != nil {
+		detail += "\n\n" + apiErrorDetail(apiErr)
+	}
+
+	if attributePath.Equal(path.Empty()) {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	diags.AddAttributeError(attributePath, summary, detail)
+}
+
+// connectMeta connects the provider before the first API call of a framework resource, like withConnection does for
+// the SDK provider.
+func connectMeta(ctx context.Context, meta *providerMeta, diags *diag.Diagnostics) bool {
+	if err := meta.connect(ctx); err != nil {
+		diags.AddError("Unable to connect to InfluxDB", err.Error())
+		return false
+	}
+
+	return true
+}
+
+// keepStateWhileUnknown reports whether a framework resource keeps its prior state when refreshed, because the provider
+// configuration is unknown, like withUnknownConfig does for the SDK provider.
+func keepStateWhileUnknown(meta *providerMeta) bool {
+	return meta.config.unknown
+}
+
+// checkWritable fails an operation of a framework resource that writes to InfluxDB if the provider is read-only,
+// like withReadOnly does for the SDK provider.
+func checkWritable(meta *providerMeta, diags *diag.Diagnostics) bool {
+	if meta.config.readOnly {
+		diags.AddError(readOnlySummary, readOnlyDetail)
+		return false
+	}
+
+	return true
+}
+
+// stringValue dereferences an optional string of the API.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// stringValueOrNull maps empty strings to null, as the SDK provider stored unset optional strings as empty strings.
+func stringValueOrNull(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*value)
+}
+
+// privateState is the private state of a resource kept by Terraform between operations.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateBool returns a boolean of the private state, or the fallback if the key is not set, e.g. for state
+// written by the SDK provider or by an import.
+func getPrivateBool(ctx context.Context, private privateState, key string, fallback bool) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, key)
+	if value == nil {
+		return fallback, diags
+	}
+	return string(value) == "true", diags
+}
+
+func setPrivateBool(ctx context.Context, private privateState, key string, value bool) diag.Diagnostics {
+	return private.SetKey(ctx, key, []byte(strconv.FormatBool(value)))
+}
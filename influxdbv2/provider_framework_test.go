@@ -0,0 +1,153 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"os"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+// sdkProviderVersionEnv is the version constraint of the last release of matrasas/influxdbv2 serving the bucket and
+// authorization resources from the SDK provider. If set, the migration tests apply that release first instead of the
+// SDK resources kept in testSDKProviderFactories.
+const sdkProviderVersionEnv = "INFLUXDBV2_SDK_PROVIDER_VERSION"
+
+// testAccMigration applies the configuration with the SDK provider, then checks the plan of the framework provider
+// reading the state it left, which must be empty unless other plan checks are given.
+func testAccMigration(t *testing.T, config func(server *influxdbtest.Server) string, planChecks ...plancheck.PlanCheck) {
+	if len(planChecks) == 0 {
+		planChecks = []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}
+	}
+
+	server := influxdbtest.New(t)
+
+	sdkStep := resource.TestStep{
+		ProtoV6ProviderFactories: testSDKProviderFactories(),
+		Config:                   config(server),
+	}
+	run := resource.UnitTest
+
+	if sdkProviderVersion := os.Getenv(sdkProviderVersionEnv); sdkProviderVersion != "" {
+		sdkStep.ProtoV6ProviderFactories = nil
+		sdkStep.ExternalProviders = map[string]resource.ExternalProvider{
+			"influxdbv2": {
+				Source:            "matrasas/influxdbv2",
+				VersionConstraint: sdkProviderVersion,
+			},
+		}
+		run = resource.Test
+	}
+
+	run(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			sdkStep,
+			{
+				ProtoV6ProviderFactories: testProviderFactories(),
+				Config:                   config(server),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: planChecks,
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBucketMigration(t *testing.T) {
+	testAccMigration(t, func(server *influxdbtest.Server) string {
+		return testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name        = "metrics"
+  org_id      = local.org_id
+  description = "raw metrics"
+  retention_rules {
+    every_seconds                = 86400
+    shard_group_duration_seconds = 3600
+  }
+}
+`)
+	})
+}
+
+func TestAccResourceBucketMigrationWithoutRetentionRules(t *testing.T) {
+	testAccMigration(t, func(server *influxdbtest.Server) string {
+		return testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = local.org_id
+}
+`)
+	})
+}
+
+func TestAccResourceBucketMigrationInfiniteRetention(t *testing.T) {
+	// The SDK state of a configured infinite rule is that of a bucket without rules, so the rule is added to the state
+	// by an update that leaves the bucket as is.
+	testAccMigration(t, func(server *influxdbtest.Server) string {
+		return testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = local.org_id
+  retention_rules {
+    every_seconds = 0
+  }
+}
+`)
+	}, plancheck.ExpectResourceAction("influxdbv2_bucket.test", plancheck.ResourceActionUpdate))
+}
+
+func TestAccResourceAuthorizationMigration(t *testing.T) {
+	testAccMigration(t, func(server *influxdbtest.Server) string {
+		return testProviderConfig(server, `
+resource "influxdbv2_authorization" "test" {
+  org_id      = local.org_id
+  description = "telegraf"
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+  permissions {
+    action = "write"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+`)
+	})
+}
+
+func TestAccResourceAuthorizationMigrationPGPKey(t *testing.T) {
+	entity, err := openpgp.NewEntity("terraform", "", "terraform@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var publicKey bytes.Buffer
+	if err := entity.Serialize(&publicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	testAccMigration(t, func(server *influxdbtest.Server) string {
+		return testProviderConfig(server, fmt.Sprintf(`
+resource "influxdbv2_authorization" "test" {
+  org_id  = local.org_id
+  pgp_key = %q
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+`, base64.StdEncoding.EncodeToString(publicKey.Bytes())))
+	})
+}
@@ -0,0 +1,246 @@
+package influxdbv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// providerMeta is the configured provider, passed to resources and data sources as meta.
+// The API areas and the server are set once connect succeeds.
+type providerMeta struct {
+	influxdbAPI
+	server serverInfo
+
+	config      providerConfig
+	connectLock sync.Mutex
+	connected   bool
+}
+
+// providerConfig is the configuration of the SDK and the framework provider.
+type providerConfig struct {
+	host         string
+	token        string
+	waitForReady string
+	readOnly     bool
+	// unknown is set while the configuration depends on values known only at apply.
+	unknown bool
+}
+
+// errProviderConfigUnknown is returned by connect while the provider configuration is unknown, e.g. when creating
+// resources while the host is still to be created. Refreshing keeps the prior state instead, see withUnknownConfig.
+var errProviderConfigUnknown = errors.New("the provider configuration depends on values known only after apply, apply the resources it depends on first, e.g. with -target")
+
+// withConnection connects the provider before each operation of the resource that may call the API. Customizing
+// the diff gets no meta while the provider configuration is unknown, so the plan shows values known after apply.
+func withConnection(resource *schema.Resource) {
+	resource.CreateContext = connectBefore(resource.CreateContext)
+	resource.ReadContext = connectBefore(resource.ReadContext)
+	resource.UpdateContext = connectBefore(resource.UpdateContext)
+	resource.DeleteContext = connectBefore(resource.DeleteContext)
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		stateContext := resource.Importer.StateContext
+		resource.Importer.StateContext = func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := meta.(*providerMeta).connect(ctx); err != nil {
+				return nil, err
+			}
+
+			return stateContext(ctx, data, meta)
+		}
+	}
+
+	if resource.CustomizeDiff != nil {
+		customizeDiff := resource.CustomizeDiff
+		resource.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if meta == nil {
+				return customizeDiff(ctx, diff, nil)
+			}
+
+			err := meta.(*providerMeta).connect(ctx)
+			if errors.Is(err, errProviderConfigUnknown) {
+				return customizeDiff(ctx, diff, nil)
+			}
+			if err != nil {
+				return err
+			}
+
+			return customizeDiff(ctx, diff, meta)
+		}
+	}
+}
+
+// connectBefore connects the provider before the operation, see withConnection.
+func connectBefore[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](operation F) F {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := meta.(*providerMeta).connect(ctx); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return operation(ctx, data, meta)
+	}
+}
+
+// withUnknownConfig keeps the prior state when refreshing the resource while the provider configuration is unknown, so
+// existing resources can be planned before the resources the configuration depends on are created or replaced.
+func withUnknownConfig(resource *schema.Resource) {
+	read := resource.ReadContext
+	if read == nil {
+		return
+	}
+
+	resource.ReadContext = func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if meta.(*providerMeta).config.unknown {
+			return nil
+		}
+
+		return read(ctx, data, meta)
+	}
+}
+
+// The diagnostic of operations refused by a read-only provider.
+const (
+	readOnlySummary = "The provider is read-only"
+	readOnlyDetail  = "The influxdbv2 provider is configured with read_only = true, so it does not create, update or delete resources. Remove read_only from the provider configuration to apply this change."
+)
+
+// withReadOnly fails creating, updating and deleting the resource before any request if the provider is read-only.
+func withReadOnly(resource *schema.Resource) {
+	resource.CreateContext = failIfReadOnly(resource.CreateContext)
+	resource.UpdateContext = failIfReadOnly(resource.UpdateContext)
+	resource.DeleteContext = failIfReadOnly(resource.DeleteContext)
+}
+
+// failIfReadOnly fails the operation if the provider is read-only, see withReadOnly.
+func failIfReadOnly[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](operation F) F {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if meta.(*providerMeta).config.readOnly {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  readOnlySummary,
+					Detail:   readOnlyDetail,
+				},
+			}
+		}
+
+		return operation(ctx, data, meta)
+	}
+}
+
+// Server flavors reported in the X-Influxdb-Build header.
+const (
+	serverFlavorOSS   = "OSS"
+	serverFlavorCloud = "Cloud"
+)
+
+// serverInfo describes the server the provider is connected to.
+// Empty fields mean the flavor or version could not be detected.
+type serverInfo struct {
+	flavor  string
+	version *version.Version
+}
+
+func (s serverInfo) String() string {
+	name := "InfluxDB"
+	if s.flavor != "" {
+		name += " " + s.flavor
+	}
+	if s.version != nil {
+		name += " " + s.version.String()
+	}
+	return name
+}
+
+// serverFeature is an API available only on some flavors or versions of InfluxDB.
+type serverFeature struct {
+	name       string
+	minVersion *version.Version
+	cloud      bool
+}
+
+var (
+	featureRemotes = serverFeature{
+		name:       "Remote connections",
+		minVersion: version.Must(version.NewVersion("2.3.0")),
+	}
+	featureReplications = serverFeature{
+		name:       "Replications",
+		minVersion: version.Must(version.NewVersion("2.3.0")),
+	}
+)
+
+// supports checks whether the server provides the feature. Servers that could not be detected are assumed to support it.
+func (s serverInfo) supports(feature serverFeature) error {
+	switch s.flavor {
+	case serverFlavorCloud:
+		if !feature.cloud {
+			return fmt.Errorf("%s are not supported by InfluxDB Cloud", feature.name)
+		}
+	case serverFlavorOSS:
+		if feature.minVersion != nil && s.version != nil && s.version.LessThan(feature.minVersion) {
+			return fmt.Errorf("%s require InfluxDB OSS %s or later, but the server is %s", feature.name, feature.minVersion, s)
+		}
+	}
+
+	return nil
+}
+
+// requireServerFeature fails the plan if the server does not provide the feature.
+func requireServerFeature(feature serverFeature) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if meta == nil {
+			return nil
+		}
+
+		return meta.(*providerMeta).server.supports(feature)
+	}
+}
+
+// detectServer reads the flavor and version of the server from the headers of the ping endpoint,
+// falling back to the version reported by the health endpoint.
+func detectServer(ctx context.Context, client influxdb2.Client) serverInfo {
+	var server serverInfo
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, client.HTTPService().ServerURL()+"ping", nil)
+	if err != nil {
+		return server
+	}
+
+	response, err := client.HTTPService().DoHTTPRequestWithResponse(request, nil)
+	if err != nil {
+		return server
+	}
+	response.Body.Close()
+
+	server.flavor = response.Header.Get("X-Influxdb-Build")
+	versionHeader := response.Header.Get("X-Influxdb-Version")
+
+	if versionHeader == "" && server.flavor != serverFlavorCloud {
+		if health, err := client.Health(ctx); err == nil && health.Version != nil {
+			versionHeader = *health.Version
+		}
+	}
+
+	// Development builds report versions such as "dev" that can not be compared.
+	if parsed, err := version.NewVersion(strings.TrimPrefix(versionHeader, "v")); err == nil {
+		server.version = parsed
+	}
+
+	return server
+}
@@ -0,0 +1,109 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/go-version"
+	"github.com/influxdata/influxdb-client-go/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+func TestServerInfoSupports(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		server   serverInfo
+		feature  serverFeature
+		expected string
+	}{
+		{"current OSS", serverInfo{serverFlavorOSS, version.Must(version.NewVersion("2.7.1"))}, featureRemotes, ""},
+		{"minimum OSS", serverInfo{serverFlavorOSS, version.Must(version.NewVersion("2.3.0"))}, featureReplications, ""},
+		{"old OSS", serverInfo{serverFlavorOSS, version.Must(version.NewVersion("2.2.1"))}, featureRemotes, "Remote connections require InfluxDB OSS 2.3.0 or later, but the server is InfluxDB OSS 2.2.1"},
+		{"OSS release candidate", serverInfo{serverFlavorOSS, version.Must(version.NewVersion("2.3.0-rc1"))}, featureReplications, "Replications require InfluxDB OSS 2.3.0 or later"},
+		{"OSS without version", serverInfo{serverFlavorOSS, nil}, featureRemotes, ""},
+		{"Cloud", serverInfo{serverFlavorCloud, nil}, featureRemotes, "Remote connections are not supported by InfluxDB Cloud"},
+		{"Cloud feature", serverInfo{serverFlavorCloud, nil}, serverFeature{name: "Buckets", cloud: true}, ""},
+		{"undetected", serverInfo{}, featureReplications, ""},
+		{"unknown flavor", serverInfo{"Enterprise", version.Must(version.NewVersion("1.0.0"))}, featureReplications, ""},
+	} {
+		err := test.server.supports(test.feature)
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", test.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", test.name, test.expected, err)
+		}
+	}
+}
+
+func TestDetectServer(t *testing.T) {
+	for _, test := range []struct {
+		build    string
+		version  string
+		expected string
+	}{
+		{"OSS", "v2.7.1", "InfluxDB OSS 2.7.1"},
+		{"OSS", "2.2.0", "InfluxDB OSS 2.2.0"},
+		{"OSS", "dev", "InfluxDB OSS"},
+		{"OSS", "", "InfluxDB OSS"},
+		{"Cloud", "", "InfluxDB Cloud"},
+		{"", "", "InfluxDB"},
+	} {
+		server := influxdbtest.New(t)
+		server.Build = test.build
+		server.Version = test.version
+
+		client := influxdb2.NewClient(server.URL, server.Token)
+		defer client.Close()
+
+		if detected := detectServer(context.Background(), client).String(); detected != test.expected {
+			t.Errorf("%s %s: expected %s, got %s", test.build, test.version, test.expected, detected)
+		}
+	}
+}
+
+func TestDetectServerUnreachable(t *testing.T) {
+	server := influxdbtest.New(t)
+	server.Close()
+
+	client := influxdb2.NewClient(server.URL, server.Token)
+	defer client.Close()
+
+	if detected := detectServer(context.Background(), client); detected.flavor != "" || detected.version != nil {
+		t.Errorf("expected an undetected server, got %s", detected)
+	}
+}
+
+func TestProviderMetaConnectRetries(t *testing.T) {
+	var ready atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ready" && ready.Load() {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"ready"}`))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	meta := newProviderMeta(providerConfig{host: server.URL, token: "token", waitForReady: "1s"})
+
+	if err := meta.connect(context.Background()); err == nil {
+		t.Fatal("expected connecting to a server that is not ready to fail")
+	}
+
+	ready.Store(true)
+
+	if err := meta.connect(context.Background()); err != nil {
+		t.Fatalf("expected connecting again to succeed, got %v", err)
+	}
+	if meta.buckets == nil {
+		t.Error("expected the API areas to be set after connecting")
+	}
+}
@@ -0,0 +1,408 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// The bucket and authorization resources below are those of the SDK provider before they moved to the framework
+// provider, kept as they were released so the migration tests can apply them without downloading a release.
+
+// testSDKProviderFactories serves the SDK provider with the bucket and authorization resources it released.
+func testSDKProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"influxdbv2": func() (tfprotov6.ProviderServer, error) {
+			p := New("test")()
+			p.ResourcesMap["influxdbv2_bucket"] = sdkResourceBucket()
+			p.ResourcesMap["influxdbv2_authorization"] = sdkResourceAuthorization()
+			withConnection(p.ResourcesMap["influxdbv2_bucket"])
+			withConnection(p.ResourcesMap["influxdbv2_authorization"])
+
+			return tf5to6server.UpgradeServer(context.Background(), p.GRPCProvider)
+		},
+	}
+}
+func sdkResourceBucket() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Bucket resource",
+		CreateContext: sdkResourceBucketCreate,
+		ReadContext:   sdkResourceBucketRead,
+		UpdateContext: sdkResourceBucketUpdate,
+		DeleteContext: sdkResourceBucketDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Bucket name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of organization in which to create a bucket.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the bucket.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"retention_rules": {
+				Description: "Rules to expire or retain data. No rules means data never expires.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"every_seconds": {
+							Description: "Duration in seconds for how long data will be kept in the database. 0 means infinite.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"shard_group_duration_seconds": {
+							Description: "Shard duration measured in seconds.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Description: "Bucket creation date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last bucket update date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Bucket type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func sdkResourceBucketCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketsClient := meta.(*providerMeta).buckets
+
+	bucket, diags := sdkMapToBucket(data)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	bucket, err := bucketsClient.CreateBucket(ctx, bucket)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(*bucket.Id)
+
+	diags = append(diags, setBucketData(data, bucket)...)
+
+	return diags
+}
+
+func sdkResourceBucketRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketsClient := meta.(*providerMeta).buckets
+
+	bucket, err := bucketsClient.FindBucketByID(ctx, data.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := setBucketData(data, bucket)
+
+	return diags
+}
+
+func sdkResourceBucketUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketsClient := meta.(*providerMeta).buckets
+
+	bucket, diags := sdkMapToBucket(data)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	bucketId := data.Id()
+	bucket.Id = &bucketId
+
+	bucket, err := bucketsClient.UpdateBucket(ctx, bucket)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = append(diags, setBucketData(data, bucket)...)
+
+	return diags
+}
+
+func sdkResourceBucketDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketsClient := meta.(*providerMeta).buckets
+
+	err := bucketsClient.DeleteBucketWithID(ctx, data.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func sdkMapToBucket(data *schema.ResourceData) (*domain.Bucket, diag.Diagnostics) {
+	orgId := data.Get("org_id").(string)
+
+	bucket := domain.Bucket{
+		Name:  data.Get("name").(string),
+		OrgID: &orgId,
+	}
+
+	description, ok := data.GetOk("description")
+	if ok {
+		tmp := description.(string)
+		bucket.Description = &tmp
+	}
+
+	retentionRules := domain.RetentionRules{}
+	for _, retentionRule := range data.Get("retention_rules").(*schema.Set).List() {
+		mapped, err := sdkMapToRetentionRule(retentionRule.(map[string]interface{}))
+		if err.HasError() {
+			return nil, err
+		}
+		retentionRules = append(retentionRules, mapped)
+	}
+	bucket.RetentionRules = retentionRules
+
+	return &bucket, nil
+}
+
+func sdkMapToRetentionRule(data map[string]interface{}) (domain.RetentionRule, diag.Diagnostics) {
+	rule := domain.RetentionRule{
+		EverySeconds: int64(data["every_seconds"].(int)),
+		Type:         domain.RetentionRuleTypeExpire,
+	}
+
+	shardGroupDuration, ok := data["shard_group_duration_seconds"]
+	if ok {
+		tmp := int64(shardGroupDuration.(int))
+		rule.ShardGroupDurationSeconds = &tmp
+	}
+
+	var diags diag.Diagnostics
+
+	if rule.ShardGroupDurationSeconds != nil && *rule.ShardGroupDurationSeconds > rule.EverySeconds {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Shard Group duration longer than Retention Period.",
+		})
+	}
+
+	return rule, diags
+}
+
+func sdkResourceAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Bucket resource",
+		CreateContext: sdkResourceAuthorizationCreate,
+		ReadContext:   sdkResourceAuthorizationRead,
+		UpdateContext: sdkResourceAuthorizationUpdate,
+		DeleteContext: sdkResourceAuthorizationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization that the authorization is scoped to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"permissions": authorizationPermissionsSchema(),
+			"description": {
+				Description: "A description of the token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"active": {
+				Description: "Status of the token. If inactive, requests using the token will be rejected.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"user_id": {
+				Description: "ID of the user that created and owns the token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"token": {
+				Description: "Token used to authenticate API requests. Empty if `pgp_key` is set.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"pgp_key": {
+				Description: "Either an ASCII-armored or a base64-encoded PGP public key. If set, the token is stored in the state only encrypted with this key, as `encrypted_token`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"encrypted_token": {
+				Description: "Token encrypted with `pgp_key`, base64-encoded. It can be decrypted with `echo <encrypted_token> | base64 -d | gpg --decrypt`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key_fingerprint": {
+				Description: "Fingerprint of `pgp_key`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Authorization creation date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last authorization update date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func sdkResourceAuthorizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	orgId := data.Get("org_id").(string)
+	userId, userOk := data.GetOk("user_id")
+	description, descriptionOk := data.GetOk("description")
+	active := data.Get("active").(bool)
+
+	authorization := &domain.Authorization{
+		OrgID:       &orgId,
+		Permissions: &[]domain.Permission{},
+	}
+
+	if active {
+		tmp := domain.AuthorizationUpdateRequestStatusActive
+		authorization.Status = &tmp
+	} else {
+		tmp := domain.AuthorizationUpdateRequestStatusInactive
+		authorization.Status = &tmp
+	}
+
+	if userOk {
+		tmp := userId.(string)
+		authorization.UserID = &tmp
+	}
+
+	if descriptionOk {
+		tmp := description.(string)
+		authorization.Description = &tmp
+	}
+
+	permissions := mapToPermissions(data.Get("permissions").(*schema.Set))
+	authorization.Permissions = &permissions
+
+	authorization, err := authClient.CreateAuthorization(ctx, authorization)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(*authorization.Id)
+
+	diags := setAuthorizationData(data, authorization)
+	diags = append(diags, setAuthorizationTokenData(data, authorization.Token)...)
+
+	return diags
+}
+
+func sdkResourceAuthorizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	authorizations, err := authClient.GetAuthorizations(ctx)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var authorization *domain.Authorization = nil
+	for _, auth := range *authorizations {
+		if *auth.Id == data.Id() {
+			authorization = &auth
+			break
+		}
+	}
+
+	if authorization == nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No InfluxDB authorization with ID " + data.Id(),
+			},
+		}
+	}
+
+	diags := setAuthorizationData(data, authorization)
+	diags = append(diags, setAuthorizationTokenData(data, authorization.Token)...)
+
+	return diags
+}
+
+func sdkResourceAuthorizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	var status domain.AuthorizationUpdateRequestStatus
+	switch data.Get("active").(bool) {
+	case true:
+		status = domain.AuthorizationUpdateRequestStatusActive
+		break
+	case false:
+		status = domain.AuthorizationUpdateRequestStatusInactive
+		break
+	}
+
+	authorization, err := authClient.UpdateAuthorizationStatusWithID(ctx, data.Id(), status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := setAuthorizationData(data, authorization)
+	diags = append(diags, setAuthorizationTokenData(data, authorization.Token)...)
+
+	return diags
+}
+
+func sdkResourceAuthorizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	err := authClient.DeleteAuthorizationWithID(ctx, data.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"terraform-provider-influxdbv2/internal/recorder"
+	"testing"
+	"time"
+)
+
+func testProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"influxdbv2": func() (tfprotov6.ProviderServer, error) {
+			providerServer, err := NewMuxServer(context.Background(), "test")
+			if err != nil {
+				return nil, err
+			}
+
+			return providerServer(), nil
+		},
+	}
+}
+
+func testProviderConfig(server *influxdbtest.Server, config string) string {
+	return testAccEnv{host: server.URL, token: server.Token, orgId: server.OrgID}.config(config)
+}
+
+// testAccEnv is the InfluxDB an acceptance test runs against.
+type testAccEnv struct {
+	host  string
+	token string
+	orgId string
+	// client sends requests of the test itself, e.g. to change resources out of band, through the cassette.
+	client influxdb2.Client
+}
+
+// config prefixes the test configuration with the provider block and a local.org_id of the organization to test in.
+func (env testAccEnv) config(config string) string {
+	return fmt.Sprintf(`
+provider "influxdbv2" {
+  host  = %q
+  token = %q
+}
+
+locals {
+  org_id = %q
+}
+`, env.host, env.token, env.orgId) + config
+}
+
+// testAccReplay runs an acceptance test from its cassette in testdata/cassettes, failing it if no cassette was recorded.
+// With INFLUXDBV2_RECORD set, the test runs against the InfluxDB at INFLUXDB_HOST instead and records its cassette again,
+// using INFLUXDB_TOKEN and the organization INFLUXDB_ORG_ID.
+func testAccReplay(t *testing.T, testCase func(env testAccEnv) resource.TestCase) {
+	path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	recording := os.Getenv(recorder.RecordEnv) != ""
+
+	var env testAccEnv
+	if recording {
+		env = testAccEnv{
+			host:  os.Getenv("INFLUXDB_HOST"),
+			token: os.Getenv("INFLUXDB_TOKEN"),
+			orgId: os.Getenv("INFLUXDB_ORG_ID"),
+		}
+		if env.host == "" || env.token == "" || env.orgId == "" {
+			t.Fatal("INFLUXDB_HOST, INFLUXDB_TOKEN and INFLUXDB_ORG_ID must be set to record cassettes")
+		}
+	} else if _, err := os.Stat(path); os.IsNotExist(err) {
+		t.Fatalf("cassette %s is not recorded, run with %s=1 to record it", path, recorder.RecordEnv)
+	}
+
+	cassette, err := recorder.Open(path, recording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { recorder.Close(path) })
+	t.Setenv(recorder.CassetteEnv, path)
+
+	if recording {
+		for name, value := range map[string]string{"host": env.host, "org_id": env.orgId} {
+			if err := cassette.SetVariable(name, value); err != nil {
+				t.Fatal(err)
+			}
+		}
+		env.client = testAccClient(t, env, cassette)
+		resource.Test(t, testCase(env))
+		return
+	}
+
+	env = testAccEnv{host: cassette.Variable("host"), token: "REDACTED", orgId: cassette.Variable("org_id")}
+	env.client = testAccClient(t, env, cassette)
+	resource.UnitTest(t, testCase(env))
+}
+
+// testAccClient returns a client for the InfluxDB of the test that records or replays its requests in the cassette.
+func testAccClient(t *testing.T, env testAccEnv, cassette *recorder.Recorder) influxdb2.Client {
+	options := influxdb2.DefaultOptions()
+	options.HTTPClient().Transport = cassette
+
+	client := influxdb2.NewClientWithOptions(env.host, env.token, options)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// testCheckDestroyed checks that none of the resources of the given type still exist on the server.
+func testCheckDestroyed(resourceType string, exists func(id string) bool) func(*terraform.State) error {
+	return func(state *terraform.State) error {
+		for _, resource := range state.RootModule().Resources {
+			if resource.Type == resourceType && exists(resource.Primary.ID) {
+				return fmt.Errorf("%s %s still exists", resourceType, resource.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testSkipBelowTerraform skips the test if the Terraform CLI running the tests is older than the given version.
+func testSkipBelowTerraform(t *testing.T, minimum string) {
+	path := os.Getenv("TF_ACC_TERRAFORM_PATH")
+	if path == "" {
+		var err error
+		if path, err = exec.LookPath("terraform"); err != nil {
+			// The test framework installs the latest Terraform CLI.
+			return
+		}
+	}
+
+	output, err := exec.Command(path, "version", "-json").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var versionOutput struct {
+		TerraformVersion string `json:"terraform_version"`
+	}
+	if err := json.Unmarshal(output, &versionOutput); err != nil {
+		t.Fatal(err)
+	}
+
+	if version.Must(version.NewVersion(versionOutput.TerraformVersion)).LessThan(version.Must(version.NewVersion(minimum))) {
+		t.Skipf("Terraform %s is required, found %s", minimum, versionOutput.TerraformVersion)
+	}
+}
+
+// testRunFunction runs a provider function with the given arguments, like Terraform does to evaluate a call.
+func testRunFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+
+	result, funcErr := definition.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatal(funcErr)
+	}
+
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestProvider(t *testing.T) {
+	if err := New("test")().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderUnknownConfig(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	config := func(revision int) string {
+		return fmt.Sprintf(`
+resource "terraform_data" "server" {
+  input            = %q
+  triggers_replace = %d
+}
+
+provider "influxdbv2" {
+  host           = terraform_data.server.output
+  token          = %q
+  wait_for_ready = "5s"
+}
+
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = %q
+}
+
+resource "influxdbv2_bucket_token" "test" {
+  org_id          = %[4]q
+  read_bucket_ids = [influxdbv2_bucket.test.id]
+}
+`, server.URL, revision, server.Token, server.OrgID)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBucketOnServer(server, "influxdbv2_bucket.test", "metrics"),
+					resource.TestCheckResourceAttrSet("influxdbv2_bucket_token.test", "token"),
+				),
+			},
+			{
+				// Replacing the server makes the host unknown while the existing resources are refreshed.
+				Config: config(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdbv2_bucket.test", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("influxdbv2_bucket_token.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: testCheckBucketOnServer(server, "influxdbv2_bucket.test", "metrics"),
+			},
+		},
+	})
+}
+
+func TestProviderReadOnly(t *testing.T) {
+	server := influxdbtest.New(t)
+	authorizations := len(server.Authorizations())
+
+	bucketConfig := testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name        = "metrics"
+  org_id      = local.org_id
+  description = "raw metrics"
+}
+`)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: bucketConfig,
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "influxdbv2" {
+  host      = %q
+  token     = %q
+  read_only = true
+}
+
+resource "influxdbv2_bucket" "test" {
+  name        = "metrics"
+  org_id      = %q
+  description = "downsampled metrics"
+}
+
+data "influxdbv2_bucket" "test" {
+  id = influxdbv2_bucket.test.id
+}
+
+resource "influxdbv2_bucket_token" "test" {
+  org_id          = %[3]q
+  read_bucket_ids = [data.influxdbv2_bucket.test.id]
+}
+`, server.URL, server.Token, server.OrgID),
+				ExpectError: regexp.MustCompile("The provider is read-only"),
+			},
+			{
+				Config: bucketConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBucketOnServer(server, "influxdbv2_bucket.test", "metrics"),
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "description", "raw metrics"),
+					func(*terraform.State) error {
+						if actual := len(server.Authorizations()); actual != authorizations {
+							return fmt.Errorf("expected %d authorizations on the server, got %d", authorizations, actual)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testInstanceAPI is an instance API area that becomes ready after a number of readiness checks.
+type testInstanceAPI struct {
+	instanceAPI
+	readyAfter int
+	checks     int
+}
+
+func (i *testInstanceAPI) ServerURL() string {
+	return "http://influxdb.test:8086"
+}
+
+func (i *testInstanceAPI) Ready(ctx context.Context) (*domain.Ready, error) {
+	i.checks++
+	if i.checks <= i.readyAfter {
+		return nil, errors.New("503 Service Unavailable")
+	}
+
+	status := domain.ReadyStatusReady
+	return &domain.Ready{Status: &status}, nil
+}
+
+func TestWaitForServerReady(t *testing.T) {
+	instance := &testInstanceAPI{readyAfter: 1}
+
+	if err := waitForServerReady(context.Background(), instance, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.checks != 2 {
+		t.Errorf("expected 2 readiness checks, got %d", instance.checks)
+	}
+}
+
+func TestWaitForServerReadyTimeout(t *testing.T) {
+	instance := &testInstanceAPI{readyAfter: math.MaxInt}
+
+	err := waitForServerReady(context.Background(), instance, 1500*time.Millisecond)
+
+	expected := "InfluxDB at http://influxdb.test:8086 is not ready after 1.5s: 503 Service Unavailable"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+	if instance.checks != 2 {
+		t.Errorf("expected 2 readiness checks, got %d", instance.checks)
+	}
+}
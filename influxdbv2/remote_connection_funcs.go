@@ -0,0 +1,18 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func setRemoteConnectionData(data *schema.ResourceData, remote *domain.RemoteConnection) diag.Diagnostics {
+	data.Set("org_id", remote.OrgID)
+	data.Set("name", remote.Name)
+	data.Set("description", remote.Description)
+	data.Set("remote_url", remote.RemoteURL)
+	data.Set("remote_org_id", remote.RemoteOrgID)
+	data.Set("allow_insecure_tls", remote.AllowInsecureTLS)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func setReplicationData(data *schema.ResourceData, replication *domain.Replication) diag.Diagnostics {
+	data.Set("org_id", replication.OrgID)
+	data.Set("name", replication.Name)
+	data.Set("description", replication.Description)
+	data.Set("remote_id", replication.RemoteID)
+	data.Set("local_bucket_id", replication.LocalBucketID)
+	data.Set("remote_bucket_id", replication.RemoteBucketID)
+	data.Set("max_queue_size_bytes", replication.MaxQueueSizeBytes)
+	data.Set("drop_non_retryable_data", replication.DropNonRetryableData)
+	data.Set("current_queue_size_bytes", replication.CurrentQueueSizeBytes)
+	data.Set("latest_response_code", replication.LatestResponseCode)
+	data.Set("latest_error_message", replication.LatestErrorMessage)
+
+	return nil
+}
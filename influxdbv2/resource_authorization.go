@@ -2,252 +2,430 @@ package influxdbv2
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/influxdata/influxdb-client-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"strings"
 )
 
-func resourceAuthorization() *schema.Resource {
-	return &schema.Resource{
-		Description:   "InfluxDB Bucket resource",
-		CreateContext: resourceAuthorizationCreate,
-		ReadContext:   resourceAuthorizationRead,
-		UpdateContext: resourceAuthorizationUpdate,
-		DeleteContext: resourceAuthorizationDelete,
-
-		Schema: map[string]*schema.Schema{
-			"org_id": {
-				Description: "ID of the organization that the authorization is scoped to.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+// authorizationResource was ported from the SDK provider without changing its schema, so existing state is read as is.
+type authorizationResource struct {
+	meta *providerMeta
+}
+
+type authorizationResourceModel struct {
+	Id             types.String      `tfsdk:"id"`
+	OrgId          types.String      `tfsdk:"org_id"`
+	Permissions    []permissionModel `tfsdk:"permissions"`
+	Description    types.String      `tfsdk:"description"`
+	Active         types.Bool        `tfsdk:"active"`
+	UserId         types.String      `tfsdk:"user_id"`
+	Token          types.String      `tfsdk:"token"`
+	PgpKey         types.String      `tfsdk:"pgp_key"`
+	EncryptedToken types.String      `tfsdk:"encrypted_token"`
+	KeyFingerprint types.String      `tfsdk:"key_fingerprint"`
+	CreatedAt      types.String      `tfsdk:"created_at"`
+	UpdatedAt      types.String      `tfsdk:"updated_at"`
+}
+
+type permissionModel struct {
+	Action   types.String              `tfsdk:"action"`
+	Resource []permissionResourceModel `tfsdk:"resource"`
+}
+
+type permissionResourceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Id    types.String `tfsdk:"id"`
+	OrgId types.String `tfsdk:"org_id"`
+}
+
+func newAuthorizationResource() resource.Resource {
+	return &authorizationResource{}
+}
+
+func (r *authorizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization"
+}
+
+func (r *authorizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "InfluxDB Bucket resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"permissions": {
-				Description: "List of permissions for an authorization. An authorization must have at least one permission.",
-				Type:        schema.TypeSet,
-				Required:    true,
-				ForceNew:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"action": {
-							Description: "Enum: 'read'|'write'.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"resource": {
-							Description: "Resource info.",
-							Type:        schema.TypeSet,
-							Required:    true,
-							MaxItems:    1,
-							MinItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Description: "Type of resource.",
-										Type:        schema.TypeString,
-										Required:    true,
-										ForceNew:    true,
-									},
-									"id": {
-										Description: "If ID is set, that is a permission for a specific resource. If it is not set, it is a permission for all resources of that resource type.",
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-									},
-									"org_id": {
-										Description: "If orgID is set, that is a permission for all resources owned by that org. If it is not set, it is a permission for all resources of that resource type.",
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-									},
-								},
-							},
-						},
-					},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization that the authorization is scoped to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"description": {
-				Description: "A description of the token.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the token.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Status of the token. If inactive, requests using the token will be rejected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user that created and owns the token.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"active": {
-				Description: "Status of the token. If inactive, requests using the token will be rejected.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     true,
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token used to authenticate API requests. Empty if `pgp_key` is set.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pgp_key": schema.StringAttribute{
+				MarkdownDescription: "Either an ASCII-armored or a base64-encoded PGP public key. If set, the token is stored in the state only encrypted with this key, as `encrypted_token`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"user_id": {
-				Description: "ID of the user that created and owns the token.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
+			"encrypted_token": schema.StringAttribute{
+				MarkdownDescription: "Token encrypted with `pgp_key`, base64-encoded. It can be decrypted with `echo <encrypted_token> | base64 -d | gpg --decrypt`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"token": {
-				Description: "Token used to authenticate API requests.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
+			"key_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Fingerprint of `pgp_key`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"created_at": {
-				Description: "Authorization creation date.",
-				Type:        schema.TypeString,
-				Computed:    true,
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Authorization creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"updated_at": {
-				Description: "Last authorization update date.",
-				Type:        schema.TypeString,
-				Computed:    true,
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last authorization update date.",
+				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"permissions": authorizationPermissionsBlock(),
+		},
+	}
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+// authorizationPermissionsBlock is the framework counterpart of authorizationPermissionsSchema.
+func authorizationPermissionsBlock() schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		MarkdownDescription: permissionsDescription,
+		Validators:          permissionsValidators,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.RequiresReplace(),
 		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: permissionSchemaAttributes(permissionAttributes),
+			Blocks: map[string]schema.Block{
+				"resource": schema.SetNestedBlock{
+					MarkdownDescription: permissionResourceDescription,
+					Validators:          permissionResourceValidators,
+					NestedObject: schema.NestedBlockObject{
+						Attributes: permissionSchemaAttributes(permissionResourceAttributes),
+					},
+				},
+			},
+		},
+	}
+}
+
+func permissionSchemaAttributes(attributes map[string]permissionAttribute) map[string]schema.Attribute {
+	schemaAttributes := map[string]schema.Attribute{}
+	for name, attribute := range attributes {
+		schemaAttributes[name] = schema.StringAttribute{
+			MarkdownDescription: attribute.description,
+			Required:            attribute.required,
+			Optional:            !attribute.required,
+			Validators:          attribute.validators,
+		}
 	}
+	return schemaAttributes
 }
 
-func resourceAuthorizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	authClient := client.AuthorizationsAPI()
+func (r *authorizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.meta = configureMeta(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *authorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
 
-	orgId := data.Get("org_id").(string)
-	userId, userOk := data.GetOk("user_id")
-	description, descriptionOk := data.GetOk("description")
-	active := data.Get("active").(bool)
+	var plan authorizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := plan.OrgId.ValueString()
+	permissions := mapModelToPermissions(plan.Permissions)
 
 	authorization := &domain.Authorization{
 		OrgID:       &orgId,
-		Permissions: &[]domain.Permission{},
+		Permissions: &permissions,
 	}
 
-	if active {
-		tmp := domain.AuthorizationUpdateRequestStatusActive
-		authorization.Status = &tmp
-	} else {
-		tmp := domain.AuthorizationUpdateRequestStatusInactive
-		authorization.Status = &tmp
+	status := authorizationStatus(plan.Active.ValueBool())
+	authorization.Status = &status
+
+	if !plan.UserId.IsUnknown() {
+		authorization.UserID = plan.UserId.ValueStringPointer()
 	}
 
-	if userOk {
-		tmp := userId.(string)
-		authorization.UserID = &tmp
+	if !plan.Description.IsUnknown() {
+		authorization.Description = plan.Description.ValueStringPointer()
 	}
 
-	if descriptionOk {
-		tmp := description.(string)
-		authorization.Description = &tmp
+	authorization, err := r.meta.authorizations.CreateAuthorization(ctx, authorization)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to create authorization", err, authorizationErrorPath(err))
+		return
 	}
 
-	var permissions []domain.Permission
-	for _, permissionData := range data.Get("permissions").(*schema.Set).List() {
-		permissionDataMap := permissionData.(map[string]interface{})
-		resourceDataMap := permissionDataMap["resource"].(*schema.Set).List()[0].(map[string]interface{})
+	// The permissions are kept as planned, they are only normalized when read.
+	permissionModels := plan.Permissions
 
-		resourceId, resourceIdOk := resourceDataMap["id"]
-		resourceOrgId, resourceOrgIdOk := resourceDataMap["org_id"]
+	resp.Diagnostics.Append(plan.setAuthorization(authorization)...)
+	plan.Permissions = permissionModels
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
 
-		permission := domain.Permission{
-			Action: domain.PermissionAction(permissionDataMap["action"].(string)),
-			Resource: domain.Resource{
-				Type: domain.ResourceType(resourceDataMap["type"].(string)),
-			},
-		}
+func (r *authorizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if keepStateWhileUnknown(r.meta) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
 
-		if resourceIdOk {
-			tmp := resourceId.(string)
-			permission.Resource.Id = &tmp
-		}
+	var state authorizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		if resourceOrgIdOk {
-			tmp := resourceOrgId.(string)
-			permission.Resource.OrgID = &tmp
+	authorizations, err := r.meta.authorizations.GetAuthorizations(ctx)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to read authorization", err, path.Empty())
+		return
+	}
+
+	var authorization *domain.Authorization = nil
+	for _, auth := range *authorizations {
+		if *auth.Id == state.Id.ValueString() {
+			authorization = &auth
+			break
 		}
+	}
+
+	if authorization == nil {
+		resp.Diagnostics.AddError("No InfluxDB authorization with ID "+state.Id.ValueString(), "")
+		return
+	}
+
+	resp.Diagnostics.Append(state.setAuthorization(authorization)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
 
-		permissions = append(permissions, permission)
+func (r *authorizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
 	}
 
-	authorization.Permissions = &permissions
+	var plan, state authorizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	authorization, err := authClient.CreateAuthorization(ctx, authorization)
+	authorization, err := r.meta.authorizations.UpdateAuthorizationStatusWithID(ctx, state.Id.ValueString(), authorizationStatus(plan.Active.ValueBool()))
 
 	if err != nil {
-		return diag.FromErr(err)
+		addAPIError(&resp.Diagnostics, "Unable to update authorization", err, path.Empty())
+		return
 	}
 
-	data.SetId(*authorization.Id)
-
-	diags := setAuthorizationData(data, authorization)
+	// Only the status can change in place, everything else is kept from the state.
+	state.Active = plan.Active
 
-	return diags
+	resp.Diagnostics.Append(state.setAuthorization(authorization)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-func resourceAuthorizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	authClient := client.AuthorizationsAPI()
+func (r *authorizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
+
+	var state authorizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	authorizations, err := authClient.GetAuthorizations(ctx)
+	err := r.meta.authorizations.DeleteAuthorizationWithID(ctx, state.Id.ValueString())
 
 	if err != nil {
-		return diag.FromErr(err)
+		addAPIError(&resp.Diagnostics, "Unable to delete authorization", err, path.Empty())
 	}
+}
 
-	var authorization *domain.Authorization = nil
-	for _, auth := range *authorizations {
-		if *auth.Id == data.Id() {
-			authorization = &auth
-			break
-		}
+func (r *authorizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setAuthorization updates the model from the authorization read from the API, like setAuthorizationData
+// and setAuthorizationTokenData do for the SDK provider. Unset strings are stored as the SDK provider did.
+func (m *authorizationResourceModel) setAuthorization(authorization *domain.Authorization) diag.Diagnostics {
+	m.Id = types.StringPointerValue(authorization.Id)
+	m.OrgId = types.StringPointerValue(authorization.OrgID)
+	m.Description = types.StringValue(stringValue(authorization.Description))
+	m.UserId = types.StringValue(stringValue(authorization.UserID))
+	m.CreatedAt = types.StringValue(authorization.CreatedAt.String())
+	m.UpdatedAt = types.StringValue(authorization.UpdatedAt.String())
+	m.Permissions = flattenPermissionModels(*authorization.Permissions)
+
+	switch *authorization.Status {
+	case domain.AuthorizationUpdateRequestStatusActive:
+		m.Active = types.BoolValue(true)
+	case domain.AuthorizationUpdateRequestStatusInactive:
+		m.Active = types.BoolValue(false)
 	}
 
-	if authorization == nil {
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "No InfluxDB authorization with ID " + data.Id(),
-			},
-		}
+	token := stringValue(authorization.Token)
+
+	if m.PgpKey.ValueString() == "" {
+		m.Token = types.StringValue(token)
+		m.EncryptedToken = types.StringNull()
+		m.KeyFingerprint = types.StringNull()
+		return nil
 	}
 
-	return setAuthorizationData(data, authorization)
-}
+	m.Token = types.StringValue("")
 
-func resourceAuthorizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	authClient := client.AuthorizationsAPI()
+	// The token is encrypted once, later reads keep the encrypted token in the state.
+	if !m.EncryptedToken.IsUnknown() && !m.EncryptedToken.IsNull() {
+		return nil
+	}
 
-	var status domain.AuthorizationUpdateRequestStatus
-	switch data.Get("active").(bool) {
-	case true:
-		status = domain.AuthorizationUpdateRequestStatusActive
-		break
-	case false:
-		status = domain.AuthorizationUpdateRequestStatusInactive
-		break
+	m.EncryptedToken = types.StringNull()
+	m.KeyFingerprint = types.StringNull()
+
+	if token == "" {
+		return nil
 	}
 
-	authorization, err := authClient.UpdateAuthorizationStatusWithID(ctx, data.Id(), status)
+	var diags diag.Diagnostics
+
+	fingerprint, encryptedToken, err := encryptWithPGPKey(m.PgpKey.ValueString(), token)
 	if err != nil {
-		return diag.FromErr(err)
+		diags.AddAttributeError(path.Root("pgp_key"), "Unable to encrypt the token", err.Error())
+		return diags
 	}
 
-	diags := setAuthorizationData(data, authorization)
+	m.KeyFingerprint = types.StringValue(fingerprint)
+	m.EncryptedToken = types.StringValue(encryptedToken)
 
 	return diags
 }
 
-func resourceAuthorizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	authClient := client.AuthorizationsAPI()
+// authorizationErrorPath returns the attribute an error of the authorizations API is about, or an empty path.
+func authorizationErrorPath(err error) path.Path {
+	apiErr := apiError(err)
+	if apiErr == nil {
+		return path.Empty()
+	}
+
+	message := strings.ToLower(apiErr.Message)
 
-	err := authClient.DeleteAuthorizationWithID(ctx, data.Id())
+	switch {
+	case strings.Contains(message, "org"):
+		return path.Root("org_id")
+	case strings.Contains(message, "user"):
+		return path.Root("user_id")
+	case strings.Contains(message, "permission") || strings.Contains(message, "action") || strings.Contains(message, "resource type"):
+		return path.Root("permissions")
+	}
 
-	if err != nil {
-		return diag.FromErr(err)
+	return path.Empty()
+}
+
+func authorizationStatus(active bool) domain.AuthorizationUpdateRequestStatus {
+	if active {
+		return domain.AuthorizationUpdateRequestStatusActive
+	}
+
+	return domain.AuthorizationUpdateRequestStatusInactive
+}
+
+func mapModelToPermissions(models []permissionModel) []domain.Permission {
+	var permissions []domain.Permission
+	for _, model := range models {
+		resourceModel := model.Resource[0]
+
+		permissions = append(permissions, domain.Permission{
+			Action: domain.PermissionAction(model.Action.ValueString()),
+			Resource: domain.Resource{
+				Type:  domain.ResourceType(resourceModel.Type.ValueString()),
+				Id:    resourceModel.Id.ValueStringPointer(),
+				OrgID: resourceModel.OrgId.ValueStringPointer(),
+			},
+		})
+	}
+
+	return permissions
+}
+
+func flattenPermissionModels(permissions []domain.Permission) []permissionModel {
+	models := []permissionModel{}
+	for _, permission := range permissions {
+		models = append(models, permissionModel{
+			Action: types.StringValue(string(permission.Action)),
+			Resource: []permissionResourceModel{
+				{
+					Type:  types.StringValue(string(permission.Resource.Type)),
+					Id:    stringValueOrNull(permission.Resource.Id),
+					OrgId: stringValueOrNull(permission.Resource.OrgID),
+				},
+			},
+		})
 	}
 
-	return nil
+	return models
 }
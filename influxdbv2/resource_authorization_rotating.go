@@ -0,0 +1,241 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"time"
+)
+
+func resourceAuthorizationRotating() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Rotating Authorization resource. Keeps a current and a previous token with the same permissions and rotates the current token every `rotation_days`. After a rotation the previous token stays active for `grace_period_hours`, is then deactivated and is deleted once the grace period has passed again.",
+		CreateContext: resourceAuthorizationRotatingCreate,
+		ReadContext:   resourceAuthorizationRotatingRead,
+		UpdateContext: resourceAuthorizationRotatingUpdate,
+		DeleteContext: resourceAuthorizationRotatingDelete,
+		CustomizeDiff: resourceAuthorizationRotatingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization that the authorizations are scoped to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"permissions": authorizationPermissionsSchema(),
+			"description": {
+				Description: "A description of the tokens.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "ID of the user that created and owns the tokens.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"rotation_days": {
+				Description:  "Number of days after which the current token is rotated.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"grace_period_hours": {
+				Description:  "Number of hours the previous token stays active after a rotation, and stays inactive before it is deleted. Twice the grace period must fit into the rotation period, so the previous token is deleted before the next rotation.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"authorization_id": {
+				Description: "ID of the current authorization.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token": {
+				Description: "Current token used to authenticate API requests.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Description: "Creation date of the current token, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_authorization_id": {
+				Description: "ID of the previous authorization. Empty if there is no previous token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_token": {
+				Description: "Previous token, kept during the grace period after a rotation.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"previous_active": {
+				Description: "Status of the previous token.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"previous_deactivated_at": {
+				Description: "Date the previous token was deactivated, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAuthorizationRotatingCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	authorization, err := createRotatingAuthorization(ctx, authClient, data)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	data.SetId(id.UniqueId())
+	setCurrentAuthorizationData(data, authorization)
+	clearPreviousAuthorizationData(data)
+
+	return nil
+}
+
+func resourceAuthorizationRotatingRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	current, err := findAuthorization(ctx, authClient, data.Get("authorization_id").(string))
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if current == nil {
+		data.SetId("")
+		return nil
+	}
+
+	data.Set("org_id", current.OrgID)
+	data.Set("description", current.Description)
+	data.Set("user_id", current.UserID)
+	data.Set("permissions", flattenPermissions(*current.Permissions))
+
+	previousId := data.Get("previous_authorization_id").(string)
+	if previousId == "" {
+		return nil
+	}
+
+	previous, err := findAuthorization(ctx, authClient, previousId)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if previous == nil {
+		clearPreviousAuthorizationData(data)
+		return nil
+	}
+
+	data.Set("previous_active", *previous.Status == domain.AuthorizationUpdateRequestStatusActive)
+
+	return nil
+}
+
+func resourceAuthorizationRotatingUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	switch {
+	case data.HasChange("authorization_id"):
+		oldCurrentId, _ := data.GetChange("authorization_id")
+		oldCurrentToken, _ := data.GetChange("token")
+		oldPreviousId, _ := data.GetChange("previous_authorization_id")
+
+		authorization, err := createRotatingAuthorization(ctx, authClient, data)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		setCurrentAuthorizationData(data, authorization)
+		data.Set("previous_authorization_id", oldCurrentId)
+		data.Set("previous_token", oldCurrentToken)
+		data.Set("previous_active", true)
+		data.Set("previous_deactivated_at", "")
+
+		if err := deleteAuthorization(ctx, authClient, oldPreviousId.(string)); err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+	case data.HasChange("previous_active"):
+		_, err := authClient.UpdateAuthorizationStatusWithID(ctx, data.Get("previous_authorization_id").(string), domain.AuthorizationUpdateRequestStatusInactive)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		data.Set("previous_active", false)
+		data.Set("previous_deactivated_at", time.Now().UTC().Format(time.RFC3339))
+
+	case data.HasChange("previous_authorization_id"):
+		oldPreviousId, _ := data.GetChange("previous_authorization_id")
+
+		if err := deleteAuthorization(ctx, authClient, oldPreviousId.(string)); err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		clearPreviousAuthorizationData(data)
+	}
+
+	return nil
+}
+
+func resourceAuthorizationRotatingDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	for _, key := range []string{"previous_authorization_id", "authorization_id"} {
+		if err := deleteAuthorization(ctx, authClient, data.Get(key).(string)); err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceAuthorizationRotatingCustomizeDiff plans the next step of the rotation based on the creation date of the current token.
+func resourceAuthorizationRotatingCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("rotation_days") && diff.NewValueKnown("grace_period_hours") {
+		if err := validateRotationGracePeriod(diff); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	switch nextRotationStep(diff, time.Now()) {
+	case rotationStepRotate:
+		for _, key := range []string{"authorization_id", "token", "created_at", "previous_authorization_id", "previous_token", "previous_active", "previous_deactivated_at"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	case rotationStepDeactivatePrevious:
+		if err := diff.SetNew("previous_active", false); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("previous_deactivated_at")
+	case rotationStepDeletePrevious:
+		for _, key := range []string{"previous_authorization_id", "previous_token", "previous_deactivated_at"} {
+			if err := diff.SetNew(key, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+	"time"
+)
+
+func TestAccResourceAuthorizationRotating(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		// The rotation period is long enough that the token is never due for rotation when the cassette is replayed.
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_authorization_rotating" "test" {
+  org_id        = local.org_id
+  description   = "tf-acc-authorization-rotating"
+  rotation_days = 36500
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("influxdbv2_authorization_rotating.test", "authorization_id"),
+						resource.TestCheckResourceAttrSet("influxdbv2_authorization_rotating.test", "token"),
+						resource.TestCheckResourceAttrSet("influxdbv2_authorization_rotating.test", "created_at"),
+						resource.TestCheckResourceAttr("influxdbv2_authorization_rotating.test", "previous_authorization_id", ""),
+					),
+				},
+			},
+		}
+	})
+}
+
+func TestNextRotationStep(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deactivatedAt := createdAt.Add(24 * time.Hour)
+	rotation := createdAt.Add(30 * 24 * time.Hour)
+
+	for _, test := range []struct {
+		name          string
+		createdAt     string
+		gracePeriod   int
+		previousId    string
+		active        bool
+		deactivatedAt string
+		now           time.Time
+		expected      rotationStep
+	}{
+		{"unknown creation date", "", 24, "", false, "", rotation, rotationStepNone},
+		{"before rotation", createdAt.Format(time.RFC3339), 24, "", false, "", rotation.Add(-time.Second), rotationStepNone},
+		{"rotation due", createdAt.Format(time.RFC3339), 24, "", false, "", rotation, rotationStepRotate},
+		{"rotation overdue", createdAt.Format(time.RFC3339), 24, "", false, "", rotation.Add(90 * 24 * time.Hour), rotationStepRotate},
+		{"rotation due with active previous", createdAt.Format(time.RFC3339), 24, "0a1b", true, "", rotation, rotationStepRotate},
+		{"rotation due with inactive previous", createdAt.Format(time.RFC3339), 24, "0a1b", false, deactivatedAt.Format(time.RFC3339), rotation, rotationStepRotate},
+		{"previous active in grace period", createdAt.Format(time.RFC3339), 24, "0a1b", true, "", deactivatedAt.Add(-time.Second), rotationStepNone},
+		{"previous active after grace period", createdAt.Format(time.RFC3339), 24, "0a1b", true, "", deactivatedAt, rotationStepDeactivatePrevious},
+		{"previous active without grace period", createdAt.Format(time.RFC3339), 0, "0a1b", true, "", createdAt, rotationStepDeactivatePrevious},
+		{"previous inactive in grace period", createdAt.Format(time.RFC3339), 24, "0a1b", false, deactivatedAt.Format(time.RFC3339), deactivatedAt.Add(24*time.Hour - time.Second), rotationStepNone},
+		{"previous inactive after grace period", createdAt.Format(time.RFC3339), 24, "0a1b", false, deactivatedAt.Format(time.RFC3339), deactivatedAt.Add(24 * time.Hour), rotationStepDeletePrevious},
+		{"previous inactive without deactivation date", createdAt.Format(time.RFC3339), 24, "0a1b", false, "", deactivatedAt, rotationStepDeletePrevious},
+	} {
+		data := schema.TestResourceDataRaw(t, resourceAuthorizationRotating().Schema, map[string]interface{}{
+			"org_id":             "0a1b2c3d4e5f0001",
+			"rotation_days":      30,
+			"grace_period_hours": test.gracePeriod,
+		})
+		data.Set("created_at", test.createdAt)
+		data.Set("previous_authorization_id", test.previousId)
+		data.Set("previous_active", test.active)
+		data.Set("previous_deactivated_at", test.deactivatedAt)
+
+		if step := nextRotationStep(data, test.now); step != test.expected {
+			t.Errorf("%s: expected step %d, got %d", test.name, test.expected, step)
+		}
+	}
+}
+
+func TestValidateRotationGracePeriod(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		rotationDays int
+		gracePeriod  int
+		valid        bool
+	}{
+		{"no grace period", 1, 0, true},
+		{"default grace period", 30, 24, true},
+		{"grace periods fill the rotation period", 1, 12, true},
+		{"grace periods exceed the rotation period", 1, 13, false},
+		{"grace period exceeds the rotation period", 1, 48, false},
+	} {
+		data := schema.TestResourceDataRaw(t, resourceAuthorizationRotating().Schema, map[string]interface{}{
+			"org_id":             "0a1b2c3d4e5f0001",
+			"rotation_days":      test.rotationDays,
+			"grace_period_hours": test.gracePeriod,
+		})
+
+		if err := validateRotationGracePeriod(data); (err == nil) != test.valid {
+			t.Errorf("%s: expected valid %t, got error %v", test.name, test.valid, err)
+		}
+	}
+}
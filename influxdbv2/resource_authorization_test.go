@@ -0,0 +1,208 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"regexp"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+const testAuthorizationConfig = `
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_authorization" "test" {
+  org_id      = local.org_id
+  description = "telegraf"
+  active      = %t
+  permissions {
+    action = "write"
+    resource {
+      id     = influxdbv2_bucket.test.id
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+`
+
+func TestResourceAuthorization(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		CheckDestroy: testCheckDestroyed("influxdbv2_authorization", func(id string) bool {
+			_, ok := server.Authorization(id)
+			return ok
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, fmt.Sprintf(testAuthorizationConfig, true)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthorizationOnServer(server, "influxdbv2_authorization.test", domain.AuthorizationUpdateRequestStatusActive),
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "description", "telegraf"),
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "active", "true"),
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "user_id", server.UserID),
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttrSet("influxdbv2_authorization.test", "token"),
+				),
+			},
+			{
+				Config: testProviderConfig(server, fmt.Sprintf(testAuthorizationConfig, false)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthorizationOnServer(server, "influxdbv2_authorization.test", domain.AuthorizationUpdateRequestStatusInactive),
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "influxdbv2_authorization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceAuthorizationPGPKey(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	entity, err := openpgp.NewEntity("terraform", "", "terraform@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var publicKey bytes.Buffer
+	if err := entity.Serialize(&publicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, fmt.Sprintf(`
+resource "influxdbv2_authorization" "test" {
+  org_id  = local.org_id
+  pgp_key = %q
+  permissions {
+    action = "read"
+    resource {
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+`, base64.StdEncoding.EncodeToString(publicKey.Bytes()))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "token", ""),
+					resource.TestCheckResourceAttrSet("influxdbv2_authorization.test", "encrypted_token"),
+					resource.TestCheckResourceAttr("influxdbv2_authorization.test", "key_fingerprint", hex.EncodeToString(entity.PrimaryKey.Fingerprint)),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAuthorizationUnknownOrg(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_authorization" "test" {
+  org_id = "0000000000000001"
+  permissions {
+    action = "read"
+    resource {
+      type = "buckets"
+    }
+  }
+}
+`),
+				ExpectError: regexp.MustCompile(`(?s)org_id\s+=\s+"0000000000000001".*organization not found.*error code\s+"not\s+found"`),
+			},
+		},
+	})
+}
+
+func testCheckAuthorizationOnServer(server *influxdbtest.Server, name string, status domain.AuthorizationUpdateRequestStatus) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+
+		authorization, ok := server.Authorization(resourceState.Primary.ID)
+		if !ok {
+			return fmt.Errorf("authorization %s does not exist on the server", resourceState.Primary.ID)
+		}
+
+		if *authorization.Status != status {
+			return fmt.Errorf("expected authorization status %q, got %q", status, *authorization.Status)
+		}
+
+		if *authorization.Token != resourceState.Primary.Attributes["token"] {
+			return fmt.Errorf("token in state does not match the server")
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceAuthorization(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		config := `
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-authorization"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_authorization" "test" {
+  org_id      = local.org_id
+  description = "tf-acc-authorization"
+  active      = %t
+  permissions {
+    action = "write"
+    resource {
+      id     = influxdbv2_bucket.test.id
+      org_id = local.org_id
+      type   = "buckets"
+    }
+  }
+}
+`
+
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(fmt.Sprintf(config, true)),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_authorization.test", "active", "true"),
+						resource.TestCheckResourceAttr("influxdbv2_authorization.test", "permissions.#", "1"),
+						resource.TestCheckResourceAttrSet("influxdbv2_authorization.test", "token"),
+					),
+				},
+				{
+					Config: env.config(fmt.Sprintf(config, false)),
+					Check:  resource.TestCheckResourceAttr("influxdbv2_authorization.test", "active", "false"),
+				},
+				{
+					ResourceName:      "influxdbv2_authorization.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		}
+	})
+}
@@ -2,202 +2,370 @@ package influxdbv2
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/influxdata/influxdb-client-go/v2"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"strings"
 )
 
-func resourceBucket() *schema.Resource {
-	return &schema.Resource{
-		Description:   "InfluxDB Bucket resource",
-		CreateContext: resourceBucketCreate,
-		ReadContext:   resourceBucketRead,
-		UpdateContext: resourceBucketUpdate,
-		DeleteContext: resourceBucketDelete,
-
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Description: "Bucket name.",
-				Type:        schema.TypeString,
-				Required:    true,
+// Private state key recording that the configuration declares an infinite retention rule.
+const privateInfiniteRetentionRule = "infinite_retention_rule"
+
+// bucketResource was ported from the SDK provider without changing its schema, so existing state is read as is.
+type bucketResource struct {
+	meta *providerMeta
+}
+
+type bucketResourceModel struct {
+	Id             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	OrgId          types.String         `tfsdk:"org_id"`
+	Description    types.String         `tfsdk:"description"`
+	RetentionRules []retentionRuleModel `tfsdk:"retention_rules"`
+	CreatedAt      types.String         `tfsdk:"created_at"`
+	UpdatedAt      types.String         `tfsdk:"updated_at"`
+	Type           types.String         `tfsdk:"type"`
+}
+
+type retentionRuleModel struct {
+	EverySeconds              types.Int64 `tfsdk:"every_seconds"`
+	ShardGroupDurationSeconds types.Int64 `tfsdk:"shard_group_duration_seconds"`
+}
+
+func newBucketResource() resource.Resource {
+	return &bucketResource{}
+}
+
+func (r *bucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+func (r *bucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "InfluxDB Bucket resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"org_id": {
-				Description: "ID of organization in which to create a bucket.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Bucket name.",
+				Required:            true,
 			},
-			"description": {
-				Description: "Description of the bucket.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of organization in which to create a bucket.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"retention_rules": {
-				Description: "Rules to expire or retain data. No rules means data never expires.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"every_seconds": {
-							Description: "Duration in seconds for how long data will be kept in the database. 0 means infinite.",
-							Type:        schema.TypeInt,
-							Required:    true,
-						},
-						"shard_group_duration_seconds": {
-							Description: "Shard duration measured in seconds.",
-							Type:        schema.TypeInt,
-							Optional:    true,
-						},
-					},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the bucket.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"created_at": {
-				Description: "Bucket creation date.",
-				Type:        schema.TypeString,
-				Computed:    true,
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Bucket creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"updated_at": {
-				Description: "Last bucket update date.",
-				Type:        schema.TypeString,
-				Computed:    true,
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last bucket update date.",
+				Computed:            true,
 			},
-			"type": {
-				Description: "Bucket type.",
-				Type:        schema.TypeString,
-				Computed:    true,
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Bucket type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
-
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+		Blocks: map[string]schema.Block{
+			"retention_rules": schema.SetNestedBlock{
+				MarkdownDescription: "Rules to expire or retain data. No rules means data never expires.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"every_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Duration in seconds for how long data will be kept in the database. 0 means infinite.",
+							Required:            true,
+						},
+						"shard_group_duration_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Shard duration measured in seconds.",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceBucketCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	bucketsClient := client.BucketsAPI()
-
-	bucket, diags := mapToBucket(data)
+func (r *bucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.meta = configureMeta(req.ProviderData, &resp.Diagnostics)
+}
 
-	if diags.HasError() {
-		return diags
+func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
 	}
 
-	bucket, err := bucketsClient.CreateBucket(ctx, bucket)
+	var plan bucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
-		return diag.FromErr(err)
+	bucket, diags := mapToBucket(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	data.SetId(*bucket.Id)
+	bucket, err := r.meta.buckets.CreateBucket(ctx, bucket)
 
-	diags = append(diags, setBucketData(data, bucket)...)
+	if err != nil {
+		var retentionRules types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("retention_rules"), &retentionRules)...)
+		errorPath, err := bucketError(err, retentionRules)
+		addAPIError(&resp.Diagnostics, "Unable to create bucket", err, errorPath)
+		return
+	}
 
-	return diags
+	infiniteRule := hasInfiniteRetentionRule(plan.RetentionRules)
+	resp.Diagnostics.Append(setPrivateBool(ctx, resp.Private, privateInfiniteRetentionRule, infiniteRule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, flattenBucket(bucket, infiniteRule))...)
 }
 
-func resourceBucketRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	bucketsClient := client.BucketsAPI()
+func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if keepStateWhileUnknown(r.meta) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
 
-	bucket, err := bucketsClient.FindBucketByID(ctx, data.Id())
+	var state bucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.meta.buckets.FindBucketByID(ctx, state.Id.ValueString())
 
 	if err != nil {
-		return diag.FromErr(err)
+		addAPIError(&resp.Diagnostics, "Unable to read bucket", err, path.Empty())
+		return
 	}
 
-	diags := setBucketData(data, bucket)
-
-	return diags
+	// Buckets created by the SDK provider have no private state, see sdkInfiniteRetentionRule.
+	infiniteRule, diags := getPrivateBool(ctx, req.Private, privateInfiniteRetentionRule, sdkInfiniteRetentionRule(state.RetentionRules))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setPrivateBool(ctx, resp.Private, privateInfiniteRetentionRule, infiniteRule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, flattenBucket(bucket, infiniteRule))...)
 }
 
-func resourceBucketUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	bucketsClient := client.BucketsAPI()
+func (r *bucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
 
-	bucket, diags := mapToBucket(data)
+	var plan bucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if diags.HasError() {
-		return diags
+	bucket, diags := mapToBucket(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	bucketId := data.Id()
+	bucketId := plan.Id.ValueString()
 	bucket.Id = &bucketId
 
-	bucket, err := bucketsClient.UpdateBucket(ctx, bucket)
+	bucket, err := r.meta.buckets.UpdateBucket(ctx, bucket)
 
 	if err != nil {
-		return diag.FromErr(err)
+		var retentionRules types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("retention_rules"), &retentionRules)...)
+		errorPath, err := bucketError(err, retentionRules)
+		addAPIError(&resp.Diagnostics, "Unable to update bucket", err, errorPath)
+		return
 	}
 
-	diags = append(diags, setBucketData(data, bucket)...)
-
-	return diags
+	infiniteRule := hasInfiniteRetentionRule(plan.RetentionRules)
+	resp.Diagnostics.Append(setPrivateBool(ctx, resp.Private, privateInfiniteRetentionRule, infiniteRule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, flattenBucket(bucket, infiniteRule))...)
 }
 
-func resourceBucketDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := *(meta.(*influxdb2.Client))
-	bucketsClient := client.BucketsAPI()
+func (r *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkWritable(r.meta, &resp.Diagnostics) || !connectMeta(ctx, r.meta, &resp.Diagnostics) {
+		return
+	}
 
-	err := bucketsClient.DeleteBucketWithID(ctx, data.Id())
+	var state bucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.meta.buckets.DeleteBucketWithID(ctx, state.Id.ValueString())
 
 	if err != nil {
-		return diag.FromErr(err)
+		addAPIError(&resp.Diagnostics, "Unable to delete bucket", err, path.Empty())
 	}
+}
 
-	return nil
+func (r *bucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func mapToBucket(data *schema.ResourceData) (*domain.Bucket, diag.Diagnostics) {
-	orgId := data.Get("org_id").(string)
+func mapToBucket(model bucketResourceModel) (*domain.Bucket, diag.Diagnostics) {
+	orgId := model.OrgId.ValueString()
 
 	bucket := domain.Bucket{
-		Name:  data.Get("name").(string),
+		Name:  model.Name.ValueString(),
 		OrgID: &orgId,
 	}
 
-	description, ok := data.GetOk("description")
-	if ok {
-		tmp := description.(string)
-		bucket.Description = &tmp
+	if !model.Description.IsUnknown() {
+		bucket.Description = model.Description.ValueStringPointer()
 	}
 
+	var diags diag.Diagnostics
+
 	retentionRules := domain.RetentionRules{}
-	for _, retentionRule := range data.Get("retention_rules").(*schema.Set).List() {
-		mapped, err := mapToRetentionRule(retentionRule.(map[string]interface{}))
-		if err.HasError() {
-			return nil, err
+	for _, ruleModel := range model.RetentionRules {
+		rule := domain.RetentionRule{
+			EverySeconds: ruleModel.EverySeconds.ValueInt64(),
+			Type:         domain.RetentionRuleTypeExpire,
 		}
-		retentionRules = append(retentionRules, mapped)
+
+		// The shard group duration is unknown when left to the server.
+		if !ruleModel.ShardGroupDurationSeconds.IsUnknown() {
+			rule.ShardGroupDurationSeconds = ruleModel.ShardGroupDurationSeconds.ValueInt64Pointer()
+		}
+
+		if rule.ShardGroupDurationSeconds != nil && *rule.ShardGroupDurationSeconds > rule.EverySeconds {
+			diags.AddError("Shard Group duration longer than Retention Period.", "")
+		}
+
+		retentionRules = append(retentionRules, rule)
 	}
 	bucket.RetentionRules = retentionRules
 
-	return &bucket, nil
+	return &bucket, diags
 }
 
-func mapToRetentionRule(data map[string]interface{}) (domain.RetentionRule, diag.Diagnostics) {
-	rule := domain.RetentionRule{
-		EverySeconds: int64(data["every_seconds"].(int)),
-		Type:         domain.RetentionRuleTypeExpire,
+// flattenBucket maps the bucket to the resource state. InfluxDB reports a bucket without retention rules with an
+// infinite rule, which is left out unless the configuration declares it.
+func flattenBucket(bucket *domain.Bucket, infiniteRule bool) bucketResourceModel {
+	model := bucketResourceModel{
+		Id:             types.StringPointerValue(bucket.Id),
+		Name:           types.StringValue(bucket.Name),
+		OrgId:          types.StringPointerValue(bucket.OrgID),
+		Description:    types.StringValue(stringValue(bucket.Description)),
+		RetentionRules: []retentionRuleModel{},
+		CreatedAt:      types.StringValue(bucket.CreatedAt.String()),
+		UpdatedAt:      types.StringValue(bucket.UpdatedAt.String()),
+		Type:           types.StringValue(""),
 	}
 
-	shardGroupDuration, ok := data["shard_group_duration_seconds"]
-	if ok {
-		tmp := int64(shardGroupDuration.(int))
-		rule.ShardGroupDurationSeconds = &tmp
+	if bucket.Type != nil {
+		model.Type = types.StringValue(string(*bucket.Type))
 	}
 
-	var diags diag.Diagnostics
+	for _, rule := range bucket.RetentionRules {
+		if rule.EverySeconds == 0 && !infiniteRule {
+			continue
+		}
 
-	if rule.ShardGroupDurationSeconds != nil && *rule.ShardGroupDurationSeconds > rule.EverySeconds {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Shard Group duration longer than Retention Period.",
+		model.RetentionRules = append(model.RetentionRules, retentionRuleModel{
+			EverySeconds:              types.Int64Value(rule.EverySeconds),
+			ShardGroupDurationSeconds: types.Int64PointerValue(rule.ShardGroupDurationSeconds),
 		})
 	}
 
-	return rule, diags
+	return model
+}
+
+// bucketError returns the attribute an error of the buckets API is about, or an empty path, and the error. InfluxDB
+// reports the offending attribute in the error message only. Terraform cannot show the configuration of a retention
+// rule in a set, so the error names the rejected rule.
+func bucketError(err error, retentionRules types.Set) (path.Path, error) {
+	apiErr := apiError(err)
+	if apiErr == nil {
+		return path.Empty(), err
+	}
+
+	message := strings.ToLower(apiErr.Message)
+
+	switch {
+	case apiErrorCode(apiErr) == "conflict" || strings.Contains(message, "name"):
+		return path.Root("name"), err
+	case strings.Contains(message, "organization"):
+		return path.Root("org_id"), err
+	case strings.Contains(message, "shard"):
+		return retentionRuleError(err, retentionRules, "shard_group_duration_seconds", func(every, shard types.Int64) bool {
+			return every.ValueInt64() != 0 && shard.ValueInt64() > every.ValueInt64()
+		})
+	case strings.Contains(message, "expiration") || strings.Contains(message, "retention"):
+		return retentionRuleError(err, retentionRules, "every_seconds", func(every, shard types.Int64) bool {
+			return every.ValueInt64() != 0 && every.ValueInt64() < 3600
+		})
+	}
+
+	return path.Empty(), err
+}
+
+// retentionRuleError returns the path of the attribute of the first retention rule rejected by InfluxDB and the error
+// naming the rule, or the path of all retention rules if no rule matches.
+func retentionRuleError(err error, retentionRules types.Set, attribute string, rejected func(every, shard types.Int64) bool) (path.Path, error) {
+	rulesPath := path.Root("retention_rules")
+
+	for _, element := range retentionRules.Elements() {
+		rule, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		every, _ := rule.Attributes()["every_seconds"].(types.Int64)
+		shard, _ := rule.Attributes()["shard_group_duration_seconds"].(types.Int64)
+
+		if rejected(every, shard) {
+			return rulesPath.AtSetValue(rule).AtName(attribute), fmt.Errorf("retention rule with %s = %s: %w", attribute, rule.Attributes()[attribute], err)
+		}
+	}
+
+	return rulesPath, err
+}
+
+// sdkInfiniteRetentionRule reports whether state written by the SDK provider holds a configured infinite rule. The SDK
+// attribute was optional and computed, so its state also holds the infinite rule InfluxDB reports for a bucket without
+// rules. A lone infinite rule is therefore taken as not configured.
+func sdkInfiniteRetentionRule(rules []retentionRuleModel) bool {
+	return len(rules) > 1 && hasInfiniteRetentionRule(rules)
+}
+
+func hasInfiniteRetentionRule(rules []retentionRuleModel) bool {
+	for _, rule := range rules {
+		if rule.EverySeconds.ValueInt64() == 0 {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,230 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"terraform-provider-influxdbv2/internal/influxdbtest"
+	"testing"
+)
+
+func TestResourceBucket(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		CheckDestroy: testCheckDestroyed("influxdbv2_bucket", func(id string) bool {
+			_, ok := server.Bucket(id)
+			return ok
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name        = "metrics"
+  org_id      = local.org_id
+  description = "raw metrics"
+  retention_rules {
+    every_seconds                = 86400
+    shard_group_duration_seconds = 3600
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBucketOnServer(server, "influxdbv2_bucket.test", "metrics"),
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "org_id", server.OrgID),
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "description", "raw metrics"),
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "type", "user"),
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "retention_rules.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("influxdbv2_bucket.test", "retention_rules.*", map[string]string{
+						"every_seconds":                "86400",
+						"shard_group_duration_seconds": "3600",
+					}),
+					resource.TestCheckResourceAttrSet("influxdbv2_bucket.test", "created_at"),
+				),
+			},
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name        = "metrics_downsampled"
+  org_id      = local.org_id
+  description = "downsampled metrics"
+  retention_rules {
+    every_seconds                = 604800
+    shard_group_duration_seconds = 86400
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBucketOnServer(server, "influxdbv2_bucket.test", "metrics_downsampled"),
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "description", "downsampled metrics"),
+					resource.TestCheckTypeSetElemNestedAttrs("influxdbv2_bucket.test", "retention_rules.*", map[string]string{
+						"every_seconds":                "604800",
+						"shard_group_duration_seconds": "86400",
+					}),
+				),
+			},
+			{
+				ResourceName:      "influxdbv2_bucket.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceBucketWithoutRetentionRules(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = local.org_id
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdbv2_bucket.test", "retention_rules.#", "0"),
+					func(state *terraform.State) error {
+						// InfluxDB reports the infinite rule of a bucket without rules, which is not configured.
+						bucket, _ := server.Bucket(state.RootModule().Resources["influxdbv2_bucket.test"].Primary.ID)
+						if len(bucket.RetentionRules) != 1 || bucket.RetentionRules[0].EverySeconds != 0 {
+							return fmt.Errorf("expected the infinite retention rule on the server, got %v", bucket.RetentionRules)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "influxdbv2_bucket.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceBucketInvalidRetention(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_bucket" "test" {
+  name   = "metrics"
+  org_id = local.org_id
+  retention_rules {
+    every_seconds                = 60
+    shard_group_duration_seconds = 60
+  }
+}
+`),
+				ExpectError: regexp.MustCompile(`(?s)retention rule with every_seconds = 60:.*greater than or equal to one\s+hour.*error code\s+"unprocessable\s+entity"`),
+			},
+		},
+	})
+}
+
+func TestResourceBucketDuplicateName(t *testing.T) {
+	server := influxdbtest.New(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server, `
+resource "influxdbv2_bucket" "first" {
+  name   = "metrics"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_bucket" "second" {
+  name   = "metrics"
+  org_id = local.org_id
+
+  depends_on = [influxdbv2_bucket.first]
+}
+`),
+				ExpectError: regexp.MustCompile(`(?s)name\s+=\s+"metrics".*bucket with name metrics already exists.*error code\s+"conflict".*terraform\s+import`),
+			},
+		},
+	})
+}
+
+func testCheckBucketOnServer(server *influxdbtest.Server, name string, bucketName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+
+		bucket, ok := server.Bucket(resourceState.Primary.ID)
+		if !ok {
+			return fmt.Errorf("bucket %s does not exist on the server", resourceState.Primary.ID)
+		}
+
+		if bucket.Name != bucketName {
+			return fmt.Errorf("expected bucket name %q, got %q", bucketName, bucket.Name)
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceBucket(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name        = "tf-acc-bucket"
+  org_id      = local.org_id
+  description = "raw metrics"
+  retention_rules {
+    every_seconds = 86400
+  }
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_bucket.test", "org_id", env.orgId),
+						resource.TestCheckResourceAttr("influxdbv2_bucket.test", "type", "user"),
+						resource.TestCheckTypeSetElemNestedAttrs("influxdbv2_bucket.test", "retention_rules.*", map[string]string{
+							"every_seconds": "86400",
+						}),
+					),
+				},
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name        = "tf-acc-bucket"
+  org_id      = local.org_id
+  description = "downsampled metrics"
+  retention_rules {
+    every_seconds = 604800
+  }
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_bucket.test", "description", "downsampled metrics"),
+						resource.TestCheckTypeSetElemNestedAttrs("influxdbv2_bucket.test", "retention_rules.*", map[string]string{
+							"every_seconds": "604800",
+						}),
+					),
+				},
+				{
+					ResourceName:      "influxdbv2_bucket.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		}
+	})
+}
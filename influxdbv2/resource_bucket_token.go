@@ -0,0 +1,160 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func resourceBucketToken() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Bucket Token resource. Creates a token with least-privilege permissions on a set of buckets.",
+		CreateContext: resourceBucketTokenCreate,
+		ReadContext:   resourceBucketTokenRead,
+		UpdateContext: resourceBucketTokenUpdate,
+		DeleteContext: resourceBucketTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization that owns the buckets.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"read_bucket_ids": {
+				Description:  "IDs of buckets the token can read from.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"read_bucket_ids", "write_bucket_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"write_bucket_ids": {
+				Description:  "IDs of buckets the token can write to.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"read_bucket_ids", "write_bucket_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"read_tasks": {
+				Description: "Whether the token can read the tasks of the organization.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"read_dashboards": {
+				Description: "Whether the token can read the dashboards of the organization.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"read_telegrafs": {
+				Description: "Whether the token can read the Telegraf configurations of the organization.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"description": {
+				Description: "A description of the token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"active": {
+				Description: "Status of the token. If inactive, requests using the token will be rejected.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"token": {
+				Description: "Token used to authenticate API requests.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Description: "Token creation date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last token update date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBucketTokenCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	orgId := data.Get("org_id").(string)
+	permissions := mapToBucketTokenPermissions(data)
+
+	authorization := &domain.Authorization{
+		OrgID:       &orgId,
+		Permissions: &permissions,
+	}
+	authorization.Status = bucketTokenStatus(data.Get("active").(bool))
+
+	if description, ok := data.GetOk("description"); ok {
+		tmp := description.(string)
+		authorization.Description = &tmp
+	}
+
+	authorization, err := authClient.CreateAuthorization(ctx, authorization)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	data.SetId(*authorization.Id)
+
+	return setBucketTokenData(data, authorization)
+}
+
+func resourceBucketTokenRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	authorization, err := findAuthorization(ctx, authClient, data.Id())
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if authorization == nil {
+		data.SetId("")
+		return nil
+	}
+
+	return setBucketTokenData(data, authorization)
+}
+
+func resourceBucketTokenUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	status := bucketTokenStatus(data.Get("active").(bool))
+
+	authorization, err := authClient.UpdateAuthorizationStatusWithID(ctx, data.Id(), *status)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return setBucketTokenData(data, authorization)
+}
+
+func resourceBucketTokenDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authClient := meta.(*providerMeta).authorizations
+
+	if err := deleteAuthorization(ctx, authClient, data.Id()); err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return nil
+}
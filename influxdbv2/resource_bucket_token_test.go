@@ -0,0 +1,144 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestAccResourceBucketToken(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-bucket-token"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_bucket_token" "test" {
+  org_id           = local.org_id
+  description      = "tf-acc-bucket-token"
+  read_bucket_ids  = [influxdbv2_bucket.test.id]
+  write_bucket_ids = [influxdbv2_bucket.test.id]
+  read_dashboards  = true
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_bucket_token.test", "active", "true"),
+						resource.TestCheckResourceAttr("influxdbv2_bucket_token.test", "read_bucket_ids.#", "1"),
+						resource.TestCheckResourceAttr("influxdbv2_bucket_token.test", "write_bucket_ids.#", "1"),
+						resource.TestCheckResourceAttr("influxdbv2_bucket_token.test", "read_dashboards", "true"),
+						resource.TestCheckResourceAttr("influxdbv2_bucket_token.test", "read_tasks", "false"),
+						resource.TestCheckResourceAttrSet("influxdbv2_bucket_token.test", "token"),
+					),
+				},
+			},
+		}
+	})
+}
+
+func TestBucketTokenPermissions(t *testing.T) {
+	orgId := "0a1b2c3d4e5f0001"
+
+	for _, test := range []struct {
+		name        string
+		config      map[string]interface{}
+		permissions []domain.Permission
+	}{
+		{
+			name: "read",
+			config: map[string]interface{}{
+				"read_bucket_ids": []interface{}{"0a1b2c3d4e5f0003", "0a1b2c3d4e5f0002"},
+			},
+			permissions: []domain.Permission{
+				orgPermission(domain.PermissionActionRead, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0002"),
+				orgPermission(domain.PermissionActionRead, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0003"),
+			},
+		},
+		{
+			name: "write",
+			config: map[string]interface{}{
+				"write_bucket_ids": []interface{}{"0a1b2c3d4e5f0002"},
+			},
+			permissions: []domain.Permission{
+				orgPermission(domain.PermissionActionWrite, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0002"),
+			},
+		},
+		{
+			name: "all",
+			config: map[string]interface{}{
+				"read_bucket_ids":  []interface{}{"0a1b2c3d4e5f0002"},
+				"write_bucket_ids": []interface{}{"0a1b2c3d4e5f0002", "0a1b2c3d4e5f0003"},
+				"read_tasks":       true,
+				"read_dashboards":  true,
+				"read_telegrafs":   true,
+			},
+			permissions: []domain.Permission{
+				orgPermission(domain.PermissionActionRead, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0002"),
+				orgPermission(domain.PermissionActionWrite, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0002"),
+				orgPermission(domain.PermissionActionWrite, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0003"),
+				orgPermission(domain.PermissionActionRead, domain.ResourceTypeTasks, orgId, ""),
+				orgPermission(domain.PermissionActionRead, domain.ResourceTypeDashboards, orgId, ""),
+				orgPermission(domain.PermissionActionRead, domain.ResourceTypeTelegrafs, orgId, ""),
+			},
+		},
+	} {
+		test.config["org_id"] = orgId
+		data := schema.TestResourceDataRaw(t, resourceBucketToken().Schema, test.config)
+
+		permissions := mapToBucketTokenPermissions(data)
+		if !reflect.DeepEqual(permissions, test.permissions) {
+			t.Errorf("%s: expected permissions %v, got %v", test.name, test.permissions, permissions)
+		}
+
+		imported := schema.TestResourceDataRaw(t, resourceBucketToken().Schema, map[string]interface{}{"org_id": orgId})
+		for key, value := range flattenBucketTokenPermissions(permissions) {
+			if err := imported.Set(key, value); err != nil {
+				t.Fatalf("%s: %s", test.name, err)
+			}
+		}
+		for _, key := range []string{"read_bucket_ids", "write_bucket_ids", "read_tasks", "read_dashboards", "read_telegrafs"} {
+			if expected, actual := data.Get(key), imported.Get(key); !reflect.DeepEqual(normalizeSet(expected), normalizeSet(actual)) {
+				t.Errorf("%s: expected %s %v, got %v", test.name, key, normalizeSet(expected), normalizeSet(actual))
+			}
+		}
+	}
+}
+
+func TestFlattenBucketTokenPermissionsIgnoresOtherPermissions(t *testing.T) {
+	orgId := "0a1b2c3d4e5f0001"
+
+	attributes := flattenBucketTokenPermissions([]domain.Permission{
+		orgPermission(domain.PermissionActionRead, domain.ResourceTypeBuckets, orgId, ""),
+		orgPermission(domain.PermissionActionWrite, domain.ResourceTypeTasks, orgId, ""),
+		orgPermission(domain.PermissionActionRead, domain.ResourceTypeDashboards, orgId, "0a1b2c3d4e5f0004"),
+		orgPermission(domain.PermissionActionWrite, domain.ResourceTypeBuckets, orgId, "0a1b2c3d4e5f0002"),
+	})
+
+	expected := map[string]interface{}{
+		"read_bucket_ids":  []string{},
+		"write_bucket_ids": []string{"0a1b2c3d4e5f0002"},
+		"read_tasks":       false,
+		"read_dashboards":  false,
+		"read_telegrafs":   false,
+	}
+	if !reflect.DeepEqual(attributes, expected) {
+		t.Errorf("expected %v, got %v", expected, attributes)
+	}
+}
+
+// normalizeSet returns the sorted elements of a set, or the value if it is not a set.
+func normalizeSet(value interface{}) interface{} {
+	if set, ok := value.(*schema.Set); ok {
+		values := stringList(set.List())
+		sort.Strings(values)
+		return values
+	}
+	return value
+}
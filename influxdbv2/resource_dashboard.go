@@ -0,0 +1,321 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+)
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Dashboard resource",
+		CreateContext: resourceDashboardCreate,
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Dashboard name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of the organization that owns the dashboard.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the dashboard.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"cell": {
+				Description: "Dashboard cells. Cells are matched by position, so changing a cell updates it in place and keeps its ID.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Cell ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the cell view.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"x": {
+							Description: "Horizontal position of the cell.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"y": {
+							Description: "Vertical position of the cell.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"w": {
+							Description: "Width of the cell.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"h": {
+							Description: "Height of the cell.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"properties": {
+							Description:      "View properties of the cell as JSON, for example `jsonencode({ type = \"xy\", ... })`. Any view type supported by InfluxDB can be used.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentViewPropertiesDiff,
+						},
+					},
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dashboardsClient := meta.(*providerMeta).dashboards
+
+	dashboard := domain.PostDashboardsJSONRequestBody{
+		OrgID: data.Get("org_id").(string),
+		Name:  data.Get("name").(string),
+	}
+
+	description, ok := data.GetOk("description")
+	if ok {
+		tmp := description.(string)
+		dashboard.Description = &tmp
+	}
+
+	response, err := dashboardsClient.PostDashboardsWithResponse(ctx, &domain.PostDashboardsParams{}, dashboard)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	var created domain.Dashboard
+	if err := json.Unmarshal(response.Body, &created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(*created.Id)
+
+	var cellIds []string
+	for i := range data.Get("cell").([]interface{}) {
+		cellId, diags := createDashboardCell(ctx, dashboardsClient, data, i)
+		if diags.HasError() {
+			return diags
+		}
+		cellIds = append(cellIds, cellId)
+	}
+
+	setDashboardCellIds(data, cellIds)
+
+	return resourceDashboardRead(ctx, data, meta)
+}
+
+func resourceDashboardRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dashboardsClient := meta.(*providerMeta).dashboards
+
+	include := domain.GetDashboardsIDParamsInclude("properties")
+	response, err := dashboardsClient.GetDashboardsIDWithResponse(ctx, data.Id(), &domain.GetDashboardsIDParams{Include: &include})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	var dashboard domain.DashboardWithViewProperties
+	if err := json.Unmarshal(response.Body, &dashboard); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setDashboardData(data, &dashboard)
+}
+
+func resourceDashboardUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dashboardsClient := meta.(*providerMeta).dashboards
+
+	if data.HasChanges("name", "description") {
+		name := data.Get("name").(string)
+		description := data.Get("description").(string)
+
+		response, err := dashboardsClient.PatchDashboardsIDWithResponse(ctx, data.Id(), &domain.PatchDashboardsIDParams{}, domain.PatchDashboardsIDJSONRequestBody{
+			Name:        &name,
+			Description: &description,
+		})
+
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		if response.JSON404 != nil {
+			return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON404, response.StatusCode()))
+		}
+
+		if response.JSONDefault != nil {
+			return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+		}
+	}
+
+	if data.HasChange("cell") {
+		oldCells, newCells := data.GetChange("cell")
+		oldCount := len(oldCells.([]interface{}))
+		newCount := len(newCells.([]interface{}))
+
+		var cellIds []string
+		for i := 0; i < newCount; i++ {
+			var cellId string
+			var diags diag.Diagnostics
+			if i < oldCount {
+				cellId = oldCells.([]interface{})[i].(map[string]interface{})["id"].(string)
+				diags = updateDashboardCell(ctx, dashboardsClient, data, i, cellId)
+			} else {
+				cellId, diags = createDashboardCell(ctx, dashboardsClient, data, i)
+			}
+			if diags.HasError() {
+				return diags
+			}
+			cellIds = append(cellIds, cellId)
+		}
+
+		for i := newCount; i < oldCount; i++ {
+			cellId := oldCells.([]interface{})[i].(map[string]interface{})["id"].(string)
+
+			response, err := dashboardsClient.DeleteDashboardsIDCellsIDWithResponse(ctx, data.Id(), cellId, &domain.DeleteDashboardsIDCellsIDParams{})
+
+			if err != nil {
+				return apiErrorDiagnostics(err)
+			}
+
+			if response.JSONDefault != nil {
+				return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+			}
+		}
+
+		setDashboardCellIds(data, cellIds)
+	}
+
+	return resourceDashboardRead(ctx, data, meta)
+}
+
+func resourceDashboardDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dashboardsClient := meta.(*providerMeta).dashboards
+
+	response, err := dashboardsClient.DeleteDashboardsIDWithResponse(ctx, data.Id(), &domain.DeleteDashboardsIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
+
+func createDashboardCell(ctx context.Context, dashboardsClient dashboardsAPI, data *schema.ResourceData, index int) (string, diag.Diagnostics) {
+	cellData := data.Get("cell").([]interface{})[index].(map[string]interface{})
+
+	update := mapToCellUpdate(cellData)
+	cell := domain.PostDashboardsIDCellsJSONRequestBody{
+		X: update.X,
+		Y: update.Y,
+		W: update.W,
+		H: update.H,
+	}
+
+	response, err := dashboardsClient.PostDashboardsIDCellsWithResponse(ctx, data.Id(), &domain.PostDashboardsIDCellsParams{}, cell)
+
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		return "", apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON404, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return "", apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	cellId := *response.JSON201.Id
+
+	return cellId, updateDashboardCellView(ctx, dashboardsClient, data.Id(), cellId, cellData)
+}
+
+func updateDashboardCell(ctx context.Context, dashboardsClient dashboardsAPI, data *schema.ResourceData, index int, cellId string) diag.Diagnostics {
+	cellData := data.Get("cell").([]interface{})[index].(map[string]interface{})
+
+	response, err := dashboardsClient.PatchDashboardsIDCellsIDWithResponse(ctx, data.Id(), cellId, &domain.PatchDashboardsIDCellsIDParams{}, domain.PatchDashboardsIDCellsIDJSONRequestBody(mapToCellUpdate(cellData)))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON404, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return updateDashboardCellView(ctx, dashboardsClient, data.Id(), cellId, cellData)
+}
+
+func updateDashboardCellView(ctx context.Context, dashboardsClient dashboardsAPI, dashboardId, cellId string, cellData map[string]interface{}) diag.Diagnostics {
+	view, err := mapToView(cellData)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	response, err := dashboardsClient.PatchDashboardsIDCellsIDViewWithResponse(ctx, dashboardId, cellId, &domain.PatchDashboardsIDCellsIDViewParams{}, domain.PatchDashboardsIDCellsIDViewJSONRequestBody(*view))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON404, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
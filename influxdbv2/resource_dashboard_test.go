@@ -0,0 +1,108 @@
+package influxdbv2
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccResourceDashboard(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_dashboard" "test" {
+  name   = "tf-acc-dashboard"
+  org_id = local.org_id
+
+  cell {
+    name = "Notes"
+    x    = 0
+    y    = 0
+    w    = 6
+    h    = 4
+    properties = jsonencode({
+      type  = "markdown"
+      shape = "chronograf-v2"
+      note  = "Managed by Terraform."
+    })
+  }
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_dashboard.test", "cell.#", "1"),
+						resource.TestCheckResourceAttrSet("influxdbv2_dashboard.test", "cell.0.id"),
+					),
+				},
+				{
+					Config: env.config(`
+resource "influxdbv2_dashboard" "test" {
+  name   = "tf-acc-dashboard"
+  org_id = local.org_id
+
+  cell {
+    name = "Notes"
+    x    = 0
+    y    = 0
+    w    = 12
+    h    = 4
+    properties = jsonencode({
+      type  = "markdown"
+      shape = "chronograf-v2"
+      note  = "Managed by Terraform."
+    })
+  }
+}
+`),
+					Check: resource.TestCheckResourceAttr("influxdbv2_dashboard.test", "cell.0.w", "12"),
+				},
+				{
+					ResourceName:      "influxdbv2_dashboard.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		}
+	})
+}
+
+func TestEquivalentJSON(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		actual     string
+		expected   string
+		equivalent bool
+	}{
+		{"equal", `{"type": "single-stat", "prefix": "%"}`, `{"prefix": "%", "type": "single-stat"}`, true},
+		{"default string", `{"type": "single-stat", "suffix": ""}`, `{"type": "single-stat"}`, true},
+		{"default bool", `{"type": "xy", "showNoteWhenEmpty": false}`, `{"type": "xy"}`, true},
+		{"default number", `{"type": "xy", "decimalPlaces": 0}`, `{"type": "xy"}`, true},
+		{"default list", `{"type": "xy", "colors": []}`, `{"type": "xy"}`, true},
+		{"default null", `{"type": "xy", "note": null}`, `{"type": "xy"}`, true},
+		{"default object", `{"type": "xy", "axes": {"x": {"label": "", "bounds": []}}}`, `{"type": "xy"}`, true},
+		{"default in configuration", `{"type": "xy"}`, `{"type": "xy", "note": ""}`, true},
+		{"nested default", `{"queries": [{"text": "from()", "editMode": ""}]}`, `{"queries": [{"text": "from()"}]}`, true},
+		{"changed value", `{"type": "single-stat", "prefix": "%"}`, `{"type": "single-stat", "prefix": "$"}`, false},
+		{"changed type", `{"decimalPlaces": 2}`, `{"decimalPlaces": "2"}`, false},
+		{"extra value", `{"type": "xy", "note": "cpu"}`, `{"type": "xy"}`, false},
+		{"missing value", `{"type": "xy"}`, `{"type": "xy", "note": "cpu"}`, false},
+		{"set object", `{"type": "xy", "axes": {"x": {"label": "time"}}}`, `{"type": "xy"}`, false},
+		{"list length", `{"colors": ["red"]}`, `{"colors": ["red", "blue"]}`, false},
+		{"list order", `{"colors": ["red", "blue"]}`, `{"colors": ["blue", "red"]}`, false},
+		{"null for value", `{"type": null}`, `{"type": "xy"}`, false},
+	} {
+		var actual, expected interface{}
+		if err := json.Unmarshal([]byte(test.actual), &actual); err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+
+		if result := equivalentJSON(actual, expected); result != test.equivalent {
+			t.Errorf("%s: expected %t, got %t", test.name, test.equivalent, result)
+		}
+	}
+}
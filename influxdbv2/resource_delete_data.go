@@ -0,0 +1,115 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+func resourceDeleteData() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Delete Data resource. Deletes data matching a predicate from a bucket on create and whenever `triggers` change. Destroying the resource does not restore any data.",
+		CreateContext: resourceDeleteDataCreate,
+		ReadContext:   resourceDeleteDataRead,
+		DeleteContext: resourceDeleteDataDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization that owns the bucket.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bucket_id": {
+				Description: "ID of the bucket to delete data from.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"start": {
+				Description:  "RFC3339 timestamp of the start of the time range to delete.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"stop": {
+				Description:  "RFC3339 timestamp of the end of the time range to delete.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"predicate": {
+				Description:      "Delete predicate, for example `_measurement=\"cpu\" AND host=\"server01\"`. No predicate means all data in the time range is deleted.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateDeletePredicate,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will run the delete again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDeleteDataCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deleteClient := meta.(*providerMeta).delete
+
+	orgId := data.Get("org_id").(string)
+	bucketId := data.Get("bucket_id").(string)
+	predicate := data.Get("predicate").(string)
+
+	start, err := time.Parse(time.RFC3339, data.Get("start").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stop, err := time.Parse(time.RFC3339, data.Get("stop").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !stop.After(start) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Stop of the time range must be after start.",
+			},
+		}
+	}
+
+	err = deleteClient.DeleteWithID(ctx, orgId, bucketId, start, stop, predicate)
+
+	if err != nil {
+		// InfluxDB rejects a predicate it cannot parse as an invalid request.
+		if apiErr := apiError(err); apiErr != nil && apiErrorCode(apiErr) == "invalid" {
+			return apiErrorAttributeDiagnostics(err, cty.GetAttrPath("predicate"))
+		}
+
+		return apiErrorDiagnostics(err)
+	}
+
+	data.SetId(id.UniqueId())
+
+	return nil
+}
+
+func resourceDeleteDataRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Deleting data is a one-off action, there is nothing to refresh.
+	return nil
+}
+
+func resourceDeleteDataDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Deleted data can not be restored, only the resource is removed from the state.
+	return nil
+}
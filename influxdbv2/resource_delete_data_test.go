@@ -0,0 +1,98 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"strings"
+	"testing"
+)
+
+func TestAccResourceDeleteData(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		config := `
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-delete-data"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_delete_data" "test" {
+  org_id    = local.org_id
+  bucket_id = influxdbv2_bucket.test.id
+  start     = "1970-01-01T00:00:00Z"
+  stop      = "2022-09-01T00:00:00Z"
+  predicate = "_measurement=\"sensors\" AND fleet=\"%s\""
+
+  triggers = {
+    fleet = "%[1]s"
+  }
+}
+`
+
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(fmt.Sprintf(config, "fleet-7")),
+					Check:  resource.TestCheckResourceAttr("influxdbv2_delete_data.test", "triggers.fleet", "fleet-7"),
+				},
+				{
+					Config: env.config(fmt.Sprintf(config, "fleet-8")),
+					Check:  resource.TestCheckResourceAttr("influxdbv2_delete_data.test", "triggers.fleet", "fleet-8"),
+				},
+			},
+		}
+	})
+}
+
+func TestParseDeletePredicate(t *testing.T) {
+	for _, predicate := range []string{
+		``,
+		`   `,
+		`_measurement="cpu"`,
+		`host!="a"`,
+		`_measurement = 'cpu' AND host = "server 1"`,
+		`region="eu" and (host="a" AND dc!="vilnius")`,
+		`((host="a"))`,
+		`"tag key"="value"`,
+		`host="say \"hi\""`,
+		`host.name-1="a"`,
+	} {
+		if err := parseDeletePredicate(predicate); err != nil {
+			t.Errorf("%s: unexpected error: %s", predicate, err)
+		}
+	}
+}
+
+func TestParseDeletePredicateInvalid(t *testing.T) {
+	for _, test := range []struct {
+		predicate string
+		expected  string
+	}{
+		{`host="a" OR host="b"`, "OR is not supported"},
+		{`host="a" or host="b"`, "OR is not supported"},
+		{`_field="usage"`, "_field is not supported"},
+		{`host="a" AND _field="usage"`, "_field is not supported"},
+		{`host="a`, "unterminated string"},
+		{`host='a"`, "unterminated string"},
+		{`(host="a"`, "missing closing parenthesis"},
+		{`host="a")`, `unexpected ")"`},
+		{`()`, `expected a tag key, got ")"`},
+		{`host>"a"`, `unexpected ">"`},
+		{`host=~"a"`, `unexpected "~"`},
+		{`host!"a"`, "only = and != comparisons are supported"},
+		{`host=="a"`, "expected a quoted string value"},
+		{`host`, "expected = or != after"},
+		{`host=a`, "expected a quoted string value"},
+		{`host="a" AND`, "unexpected end of predicate"},
+		{`host="a" host="b"`, `unexpected "host"`},
+	} {
+		err := parseDeletePredicate(test.predicate)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.predicate)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s: expected error containing %q, got %q", test.predicate, test.expected, err)
+		}
+	}
+}
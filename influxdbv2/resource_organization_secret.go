@@ -0,0 +1,168 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"strings"
+)
+
+func resourceOrganizationSecret() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Organization Secret resource. Manages a single secret key that Flux tasks can read with `secrets.get()`.",
+		CreateContext: resourceOrganizationSecretCreate,
+		ReadContext:   resourceOrganizationSecretRead,
+		UpdateContext: resourceOrganizationSecretUpdate,
+		DeleteContext: resourceOrganizationSecretDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization that owns the secret.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Description: "Secret key.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"value": {
+				Description: "Secret value. The value is not stored in the state and the InfluxDB API never returns secret values, so changes of the value are not detected. Change `value_version` to update the secret.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				StateFunc:   discardSecretValue,
+			},
+			"value_version": {
+				Description: "Version of the secret value. Changing it updates the secret with the configured value.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationSecretImport,
+		},
+	}
+}
+
+func resourceOrganizationSecretCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgId := data.Get("org_id").(string)
+	key := data.Get("key").(string)
+
+	diags := patchOrganizationSecret(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.SetId(orgId + "/" + key)
+
+	return nil
+}
+
+func resourceOrganizationSecretRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgsClient := meta.(*providerMeta).organizations
+
+	orgId := data.Get("org_id").(string)
+	key := data.Get("key").(string)
+
+	response, err := orgsClient.GetOrgsIDSecretsWithResponse(ctx, orgId, &domain.GetOrgsIDSecretsParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	// The secrets are gone with their organization.
+	if response.StatusCode() == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	if response.JSON200.Secrets != nil {
+		for _, secret := range *response.JSON200.Secrets {
+			if secret == key {
+				return nil
+			}
+		}
+	}
+
+	data.SetId("")
+
+	return nil
+}
+
+func resourceOrganizationSecretUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return patchOrganizationSecret(ctx, data, meta)
+}
+
+func resourceOrganizationSecretDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgsClient := meta.(*providerMeta).organizations
+
+	orgId := data.Get("org_id").(string)
+	keys := []string{data.Get("key").(string)}
+
+	response, err := orgsClient.PostOrgsIDSecretsWithResponse(ctx, orgId, &domain.PostOrgsIDSecretsParams{}, domain.PostOrgsIDSecretsJSONRequestBody{Secrets: &keys})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	// A deleted organization took the secret with it.
+	if response.JSONDefault != nil && response.StatusCode() != http.StatusNotFound {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
+
+// resourceOrganizationSecretImport accepts "<org_id>/<key>".
+func resourceOrganizationSecretImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	orgId, key, ok := strings.Cut(data.Id(), "/")
+	if !ok || orgId == "" || key == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <org_id>/<key>", data.Id())
+	}
+
+	data.Set("org_id", orgId)
+	data.Set("key", key)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func patchOrganizationSecret(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgsClient := meta.(*providerMeta).organizations
+
+	orgId := data.Get("org_id").(string)
+	key := data.Get("key").(string)
+
+	// The planned value is emptied by the StateFunc, the plain value is only available in the configuration.
+	value := data.GetRawConfig().GetAttr("value").AsString()
+
+	// The generated request body type drops the secret map when marshalled, so the body is encoded here.
+	body, err := json.Marshal(map[string]string{key: value})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := orgsClient.PatchOrgsIDSecretsWithBodyWithResponse(ctx, orgId, &domain.PatchOrgsIDSecretsParams{}, "application/json", bytes.NewReader(body))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
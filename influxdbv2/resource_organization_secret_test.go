@@ -0,0 +1,89 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"testing"
+)
+
+func TestAccResourceOrganizationSecret(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_organization_secret" "test" {
+  org_id = local.org_id
+  key    = "TF_ACC_SECRET"
+  value  = "s3cr3t"
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_organization_secret.test", "id", env.orgId+"/TF_ACC_SECRET"),
+						resource.TestCheckResourceAttr("influxdbv2_organization_secret.test", "value", ""),
+					),
+				},
+				{
+					Config: env.config(`
+resource "influxdbv2_organization_secret" "test" {
+  org_id        = local.org_id
+  key           = "TF_ACC_SECRET"
+  value         = "n3w-s3cr3t"
+  value_version = 2
+}
+`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("influxdbv2_organization_secret.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.TestCheckResourceAttr("influxdbv2_organization_secret.test", "value", ""),
+				},
+				{
+					ResourceName:            "influxdbv2_organization_secret.test",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"value", "value_version"},
+				},
+			},
+		}
+	})
+}
+
+func TestAccResourceOrganizationSecretOrganizationDeleted(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		org, err := env.client.OrganizationsAPI().CreateOrganizationWithName(context.Background(), "tf-acc-organization-secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		config := env.config(`
+resource "influxdbv2_organization_secret" "test" {
+  org_id = "` + *org.Id + `"
+  key    = "TF_ACC_SECRET"
+  value  = "s3cr3t"
+}
+`)
+
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+				},
+				{
+					PreConfig: func() {
+						if err := env.client.OrganizationsAPI().DeleteOrganizationWithID(context.Background(), *org.Id); err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config:             config,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePoints() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Points resource. Writes static points into a bucket and deletes their series on destroy.",
+		CreateContext: resourcePointsCreate,
+		ReadContext:   resourcePointsRead,
+		DeleteContext: resourcePointsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization that owns the bucket.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bucket_id": {
+				Description: "ID of the bucket to write points into.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"precision": {
+				Description:  "Precision of timestamps. Enum: 'ns'|'us'|'ms'|'s'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ns",
+				ValidateFunc: validation.StringInSlice([]string{"ns", "us", "ms", "s"}, false),
+			},
+			"line_protocol": {
+				Description:  "Points in line protocol, one per line.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"line_protocol", "point"},
+			},
+			"point": {
+				Description:  "Structured point.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"line_protocol", "point"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"measurement": {
+							Description: "Measurement name.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"tags": {
+							Description: "Tag set of the point.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"fields": {
+							Description: "Field set of the point. Values use line protocol syntax: `1.5` for floats, `3i` for integers, `3u` for unsigned integers, `true` for booleans and `\"text\"` for strings.",
+							Type:        schema.TypeMap,
+							Required:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"timestamp": {
+							Description:  "RFC3339 timestamp of the point. If not set, the server time of the write is used.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePointsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	writeClient := meta.(*providerMeta).write
+
+	orgId := data.Get("org_id").(string)
+	bucketId := data.Get("bucket_id").(string)
+	precision := precisions[data.Get("precision").(string)]
+
+	var err error
+	lines, hasLineProtocol := data.GetOk("line_protocol")
+	if hasLineProtocol {
+		err = writeClient.WriteRecord(ctx, orgId, bucketId, precision, splitLineProtocol(lines.(string))...)
+	} else {
+		points, diags := mapToPoints(data)
+		if diags.HasError() {
+			return diags
+		}
+		err = writeClient.WritePoint(ctx, orgId, bucketId, precision, points...)
+	}
+
+	if err != nil {
+		// InfluxDB rejects lines it cannot parse as an invalid request.
+		if apiErr := apiError(err); hasLineProtocol && apiErr != nil && apiErrorCode(apiErr) == "invalid" {
+			return apiErrorAttributeDiagnostics(err, cty.GetAttrPath("line_protocol"))
+		}
+
+		return apiErrorDiagnostics(err)
+	}
+
+	data.SetId(id.UniqueId())
+
+	return nil
+}
+
+func resourcePointsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Written points can not be read back without a query, the state is kept as configured.
+	return nil
+}
+
+func resourcePointsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deleteClient := meta.(*providerMeta).delete
+
+	orgId := data.Get("org_id").(string)
+	bucketId := data.Get("bucket_id").(string)
+
+	var series []pointSeries
+	if lines, ok := data.GetOk("line_protocol"); ok {
+		for _, line := range splitLineProtocol(lines.(string)) {
+			series = append(series, parseSeries(line))
+		}
+	} else {
+		points, diags := mapToPoints(data)
+		if diags.HasError() {
+			return diags
+		}
+		for _, point := range points {
+			series = append(series, pointToSeries(point))
+		}
+	}
+
+	for _, predicate := range seriesPredicates(series) {
+		err := deleteClient.DeleteWithID(ctx, orgId, bucketId, minPointTime, maxPointTime, predicate)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"reflect"
+	"testing"
+)
+
+func TestAccResourcePoints(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-points"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_points" "test" {
+  org_id    = local.org_id
+  bucket_id = influxdbv2_bucket.test.id
+  precision = "s"
+
+  line_protocol = <<-EOT
+    slo,service=api target=99.9 1640995200
+    slo,service=web target=99.5 1640995200
+  EOT
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_points.test", "precision", "s"),
+						resource.TestCheckResourceAttr("influxdbv2_points.test", "point.#", "0"),
+					),
+				},
+			},
+		}
+	})
+}
+
+func TestParseFieldValue(t *testing.T) {
+	for _, test := range []struct {
+		value    string
+		expected interface{}
+	}{
+		{`"up"`, "up"},
+		{`""`, ""},
+		{`"say \"hi\""`, `say "hi"`},
+		{`"42i"`, "42i"},
+		{"42i", int64(42)},
+		{"-7i", int64(-7)},
+		{"42u", uint64(42)},
+		{"t", true},
+		{"TRUE", true},
+		{"True", true},
+		{"f", false},
+		{"false", false},
+		{"99.9", 99.9},
+		{"-1e3", -1000.0},
+		{"42", 42.0},
+	} {
+		actual, err := parseFieldValue(test.value)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.value, err)
+			continue
+		}
+		if actual != test.expected {
+			t.Errorf("%s: expected %#v, got %#v", test.value, test.expected, actual)
+		}
+	}
+}
+
+func TestParseFieldValueInvalid(t *testing.T) {
+	for _, value := range []string{"", `"`, "up", "4.2i", "-1u", "yes", "1,5"} {
+		if actual, err := parseFieldValue(value); err == nil {
+			t.Errorf("%s: expected an error, got %#v", value, actual)
+		}
+	}
+}
+
+func TestSeriesPredicates(t *testing.T) {
+	cpuPoint := write.NewPointWithMeasurement("cpu").AddTag("region", "eu").AddTag("host", "a").AddField("value", 1.0)
+
+	series := []pointSeries{
+		parseSeries(`slo,service=api target=99.9 1640995200`),
+		parseSeries(`slo,service=api target=99.5 1640995260`),
+		parseSeries(`my\ measurement,tag\ key=a\=b\,c value=1`),
+		parseSeries(`quotes,name=say"hi" value=1`),
+		parseSeries(`bare value=1`),
+		pointToSeries(cpuPoint),
+	}
+
+	expected := []string{
+		`_measurement="slo" AND service="api"`,
+		`_measurement="my measurement" AND tag key="a=b,c"`,
+		`_measurement="quotes" AND name="say\"hi\""`,
+		`_measurement="bare"`,
+		`_measurement="cpu" AND host="a" AND region="eu"`,
+	}
+
+	if actual := seriesPredicates(series); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
@@ -0,0 +1,187 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func resourceRemoteConnection() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Remote Connection resource. Connection to a remote InfluxDB instance used by replications.",
+		CreateContext: resourceRemoteConnectionCreate,
+		ReadContext:   resourceRemoteConnectionRead,
+		UpdateContext: resourceRemoteConnectionUpdate,
+		DeleteContext: resourceRemoteConnectionDelete,
+		CustomizeDiff: requireServerFeature(featureRemotes),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Remote connection name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of the local organization that owns the remote connection.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the remote connection.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"remote_url": {
+				Description:  "URL of the remote InfluxDB instance.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"remote_org_id": {
+				Description: "ID of the organization on the remote InfluxDB instance.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"remote_api_token": {
+				Description: "API token used to write to the remote InfluxDB instance.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"allow_insecure_tls": {
+				Description: "Skip TLS verification of the remote InfluxDB instance.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceRemoteConnectionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	remotesClient := meta.(*providerMeta).remotes
+
+	remote := domain.PostRemoteConnectionJSONRequestBody{
+		Name:             data.Get("name").(string),
+		OrgID:            data.Get("org_id").(string),
+		RemoteURL:        data.Get("remote_url").(string),
+		RemoteOrgID:      data.Get("remote_org_id").(string),
+		RemoteAPIToken:   data.Get("remote_api_token").(string),
+		AllowInsecureTLS: data.Get("allow_insecure_tls").(bool),
+	}
+
+	description, ok := data.GetOk("description")
+	if ok {
+		tmp := description.(string)
+		remote.Description = &tmp
+	}
+
+	response, err := remotesClient.PostRemoteConnectionWithResponse(ctx, remote)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON400 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON400, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	data.SetId(response.JSON201.Id)
+
+	return setRemoteConnectionData(data, response.JSON201)
+}
+
+func resourceRemoteConnectionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	remotesClient := meta.(*providerMeta).remotes
+
+	response, err := remotesClient.GetRemoteConnectionByIDWithResponse(ctx, data.Id(), &domain.GetRemoteConnectionByIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setRemoteConnectionData(data, response.JSON200)
+}
+
+func resourceRemoteConnectionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	remotesClient := meta.(*providerMeta).remotes
+
+	name := data.Get("name").(string)
+	description := data.Get("description").(string)
+	remoteUrl := data.Get("remote_url").(string)
+	remoteOrgId := data.Get("remote_org_id").(string)
+	allowInsecureTls := data.Get("allow_insecure_tls").(bool)
+
+	remote := domain.PatchRemoteConnectionByIDJSONRequestBody{
+		Name:             &name,
+		Description:      &description,
+		RemoteURL:        &remoteUrl,
+		RemoteOrgID:      &remoteOrgId,
+		AllowInsecureTLS: &allowInsecureTls,
+	}
+
+	if data.HasChange("remote_api_token") {
+		tmp := data.Get("remote_api_token").(string)
+		remote.RemoteAPIToken = &tmp
+	}
+
+	response, err := remotesClient.PatchRemoteConnectionByIDWithResponse(ctx, data.Id(), &domain.PatchRemoteConnectionByIDParams{}, remote)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON400 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON400, response.StatusCode()))
+	}
+
+	if response.JSON404 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON404, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setRemoteConnectionData(data, response.JSON200)
+}
+
+func resourceRemoteConnectionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	remotesClient := meta.(*providerMeta).remotes
+
+	response, err := remotesClient.DeleteRemoteConnectionByIDWithResponse(ctx, data.Id(), &domain.DeleteRemoteConnectionByIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
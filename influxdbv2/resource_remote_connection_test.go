@@ -0,0 +1,34 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccResourceRemoteConnection(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_remote_connection" "test" {
+  name             = "tf-acc-remote-connection"
+  org_id           = local.org_id
+  remote_url       = "https://influxdb.example.com"
+  remote_org_id    = "0000000000000001"
+  remote_api_token = "remote-token"
+}
+`),
+					Check: resource.TestCheckResourceAttr("influxdbv2_remote_connection.test", "allow_insecure_tls", "false"),
+				},
+				{
+					ResourceName:            "influxdbv2_remote_connection.test",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"remote_api_token"},
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func resourceReplication() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Replication resource. Replicates data written to a local bucket into a bucket of a remote connection.",
+		CreateContext: resourceReplicationCreate,
+		ReadContext:   resourceReplicationRead,
+		UpdateContext: resourceReplicationUpdate,
+		DeleteContext: resourceReplicationDelete,
+		CustomizeDiff: requireServerFeature(featureReplications),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Replication name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of the local organization that owns the replication.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the replication.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"remote_id": {
+				Description: "ID of the remote connection to replicate to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"local_bucket_id": {
+				Description: "ID of the local bucket to replicate from.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"remote_bucket_id": {
+				Description: "ID of the remote bucket to replicate to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"max_queue_size_bytes": {
+				Description:  "Maximum size of the replication queue on disk in bytes.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      67108860,
+				ValidateFunc: validation.IntAtLeast(33554430),
+			},
+			"drop_non_retryable_data": {
+				Description: "Drop data that the remote rejects with a non-retryable error instead of keeping it in the queue.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"current_queue_size_bytes": {
+				Description: "Current size of the replication queue in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"latest_response_code": {
+				Description: "HTTP status code of the latest response from the remote.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"latest_error_message": {
+				Description: "Latest error message received from the remote.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceReplicationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	replicationsClient := meta.(*providerMeta).replications
+
+	dropNonRetryableData := data.Get("drop_non_retryable_data").(bool)
+
+	replication := domain.PostReplicationJSONRequestBody{
+		Name:                 data.Get("name").(string),
+		OrgID:                data.Get("org_id").(string),
+		RemoteID:             data.Get("remote_id").(string),
+		LocalBucketID:        data.Get("local_bucket_id").(string),
+		RemoteBucketID:       data.Get("remote_bucket_id").(string),
+		MaxQueueSizeBytes:    int64(data.Get("max_queue_size_bytes").(int)),
+		DropNonRetryableData: &dropNonRetryableData,
+	}
+
+	description, ok := data.GetOk("description")
+	if ok {
+		tmp := description.(string)
+		replication.Description = &tmp
+	}
+
+	response, err := replicationsClient.PostReplicationWithResponse(ctx, &domain.PostReplicationParams{}, replication)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON400 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON400, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	data.SetId(response.JSON201.Id)
+
+	return setReplicationData(data, response.JSON201)
+}
+
+func resourceReplicationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	replicationsClient := meta.(*providerMeta).replications
+
+	response, err := replicationsClient.GetReplicationByIDWithResponse(ctx, data.Id(), &domain.GetReplicationByIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setReplicationData(data, response.JSON200)
+}
+
+func resourceReplicationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	replicationsClient := meta.(*providerMeta).replications
+
+	name := data.Get("name").(string)
+	description := data.Get("description").(string)
+	remoteId := data.Get("remote_id").(string)
+	remoteBucketId := data.Get("remote_bucket_id").(string)
+	maxQueueSizeBytes := int64(data.Get("max_queue_size_bytes").(int))
+	dropNonRetryableData := data.Get("drop_non_retryable_data").(bool)
+
+	replication := domain.PatchReplicationByIDJSONRequestBody{
+		Name:                 &name,
+		Description:          &description,
+		RemoteID:             &remoteId,
+		RemoteBucketID:       &remoteBucketId,
+		MaxQueueSizeBytes:    &maxQueueSizeBytes,
+		DropNonRetryableData: &dropNonRetryableData,
+	}
+
+	response, err := replicationsClient.PatchReplicationByIDWithResponse(ctx, data.Id(), &domain.PatchReplicationByIDParams{}, replication)
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON400 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON400, response.StatusCode()))
+	}
+
+	if response.JSON404 != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSON404, response.StatusCode()))
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setReplicationData(data, response.JSON200)
+}
+
+func resourceReplicationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	replicationsClient := meta.(*providerMeta).replications
+
+	response, err := replicationsClient.DeleteReplicationByIDWithResponse(ctx, data.Id(), &domain.DeleteReplicationByIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccResourceReplication(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-replication"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_remote_connection" "test" {
+  name             = "tf-acc-replication"
+  org_id           = local.org_id
+  remote_url       = "https://influxdb.example.com"
+  remote_org_id    = "0000000000000001"
+  remote_api_token = "remote-token"
+}
+
+resource "influxdbv2_replication" "test" {
+  name                 = "tf-acc-replication"
+  org_id               = local.org_id
+  remote_id            = influxdbv2_remote_connection.test.id
+  local_bucket_id      = influxdbv2_bucket.test.id
+  remote_bucket_id     = "0000000000000002"
+  max_queue_size_bytes = 67108860
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_replication.test", "max_queue_size_bytes", "67108860"),
+						resource.TestCheckResourceAttrSet("influxdbv2_replication.test", "current_queue_size_bytes"),
+					),
+				},
+				{
+					ResourceName:      "influxdbv2_replication.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+					// The size of the queue changes as InfluxDB writes it to disk.
+					ImportStateVerifyIgnore: []string{"current_queue_size_bytes"},
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+)
+
+func resourceScraperTarget() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Scraper Target resource",
+		CreateContext: resourceScraperTargetCreate,
+		ReadContext:   resourceScraperTargetRead,
+		UpdateContext: resourceScraperTargetUpdate,
+		DeleteContext: resourceScraperTargetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Scraper target name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"url": {
+				Description:  "URL of the metrics endpoint.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"type": {
+				Description:  "Type of the metrics to be parsed. Enum: 'prometheus'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(domain.ScraperTargetRequestTypePrometheus),
+				ValidateFunc: validation.StringInSlice([]string{string(domain.ScraperTargetRequestTypePrometheus)}, false),
+			},
+			"bucket_id": {
+				Description: "ID of the bucket to write to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of the organization that owns the scraper target.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"allow_insecure": {
+				Description: "Skip TLS verification on the endpoint.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"bucket": {
+				Description: "Name of the bucket to write to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceScraperTargetCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scrapersClient := meta.(*providerMeta).scrapers
+
+	scraper := mapToScraperTargetRequest(data)
+
+	response, err := scrapersClient.PostScrapersWithResponse(ctx, &domain.PostScrapersParams{}, domain.PostScrapersJSONRequestBody(*scraper))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	data.SetId(*response.JSON201.Id)
+
+	return setScraperTargetData(data, response.JSON201)
+}
+
+func resourceScraperTargetRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scrapersClient := meta.(*providerMeta).scrapers
+
+	response, err := scrapersClient.GetScrapersIDWithResponse(ctx, data.Id(), &domain.GetScrapersIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setScraperTargetData(data, response.JSON200)
+}
+
+func resourceScraperTargetUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scrapersClient := meta.(*providerMeta).scrapers
+
+	scraper := mapToScraperTargetRequest(data)
+
+	response, err := scrapersClient.PatchScrapersIDWithResponse(ctx, data.Id(), &domain.PatchScrapersIDParams{}, domain.PatchScrapersIDJSONRequestBody(*scraper))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setScraperTargetData(data, response.JSON200)
+}
+
+func resourceScraperTargetDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scrapersClient := meta.(*providerMeta).scrapers
+
+	response, err := scrapersClient.DeleteScrapersIDWithResponse(ctx, data.Id(), &domain.DeleteScrapersIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"testing"
+)
+
+func TestAccResourceScraperTarget(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-scraper-target"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_scraper_target" "test" {
+  name      = "tf-acc-scraper-target"
+  url       = "http://localhost:9100/metrics"
+  type      = "prometheus"
+  bucket_id = influxdbv2_bucket.test.id
+  org_id    = local.org_id
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_scraper_target.test", "type", "prometheus"),
+						resource.TestCheckResourceAttr("influxdbv2_scraper_target.test", "allow_insecure", "false"),
+					),
+				},
+				{
+					ResourceName:      "influxdbv2_scraper_target.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		}
+	})
+}
+
+func TestAccResourceScraperTargetDeletedOutOfBand(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		config := env.config(`
+resource "influxdbv2_bucket" "test" {
+  name   = "tf-acc-scraper-target-deleted"
+  org_id = local.org_id
+}
+
+resource "influxdbv2_scraper_target" "test" {
+  name      = "tf-acc-scraper-target-deleted"
+  url       = "http://localhost:9100/metrics"
+  type      = "prometheus"
+  bucket_id = influxdbv2_bucket.test.id
+  org_id    = local.org_id
+}
+`)
+
+		var scraperId string
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.TestCheckResourceAttrWith("influxdbv2_scraper_target.test", "id", func(value string) error {
+						scraperId = value
+						return nil
+					}),
+				},
+				{
+					PreConfig: func() {
+						scrapers := newClientAPI(env.client).scrapers
+						response, err := scrapers.DeleteScrapersIDWithResponse(context.Background(), scraperId, &domain.DeleteScrapersIDParams{})
+						if err != nil {
+							t.Fatal(err)
+						}
+						if response.JSONDefault != nil {
+							t.Fatal(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+						}
+					},
+					Config: config,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("influxdbv2_scraper_target.test", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,258 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func resourceStack() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Stack resource. Applies InfluxDB templates, such as community templates, as a stack. Planning runs a dry-run apply and destroying the stack uninstalls its resources.",
+		CreateContext: resourceStackCreate,
+		ReadContext:   resourceStackRead,
+		UpdateContext: resourceStackUpdate,
+		DeleteContext: resourceStackDelete,
+		CustomizeDiff: resourceStackCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "ID of the organization to apply the templates to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "Stack name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Description of the stack.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"urls": {
+				Description:  "URLs of templates to apply.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"urls", "files", "templates"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"files": {
+				Description:  "Paths of local template files in YAML or JSON to apply.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"urls", "files", "templates"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"templates": {
+				Description:  "Inline templates in YAML or JSON to apply.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"urls", "files", "templates"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"env_refs": {
+				Description: "Values of the environment references used in the templates.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Description: "Resources installed by the stack.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Description: "Kind of the resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_id": {
+							Description: "ID of the resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"template_meta_name": {
+							Description: "Name of the resource in the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"dry_run_diff": {
+				Description: "Changes reported by the dry-run apply during planning, as JSON, or empty if there are none. Kept by apply until the next refresh, so it lists the changes the apply made.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStackCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templatesClient := meta.(*providerMeta).templates
+
+	orgId := data.Get("org_id").(string)
+	name := data.Get("name").(string)
+	description := data.Get("description").(string)
+	urls := stringList(data.Get("urls").([]interface{}))
+
+	response, err := templatesClient.CreateStackWithResponse(ctx, domain.CreateStackJSONRequestBody{
+		OrgID:       &orgId,
+		Name:        &name,
+		Description: &description,
+		Urls:        &urls,
+	})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	data.SetId(*response.JSON201.Id)
+
+	request, err := mapToTemplateApply(data, data.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := applyTemplate(ctx, templatesClient, request); err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return readStack(ctx, data, meta)
+}
+
+func resourceStackRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Pending changes are reported again by the dry-run apply of the next plan.
+	data.Set("dry_run_diff", "")
+
+	return readStack(ctx, data, meta)
+}
+
+// readStack reads the stack without touching dry_run_diff, so Create and Update keep the planned changes.
+func readStack(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templatesClient := meta.(*providerMeta).templates
+
+	response, err := templatesClient.ReadStackWithResponse(ctx, data.Id())
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		if response.StatusCode() == 404 {
+			data.SetId("")
+			return nil
+		}
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setStackData(data, response.JSON200)
+}
+
+func resourceStackUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templatesClient := meta.(*providerMeta).templates
+
+	if data.HasChanges("name", "description", "urls") {
+		name := data.Get("name").(string)
+		description := data.Get("description").(string)
+		urls := stringList(data.Get("urls").([]interface{}))
+
+		response, err := templatesClient.UpdateStackWithResponse(ctx, data.Id(), domain.UpdateStackJSONRequestBody{
+			Name:         &name,
+			Description:  &description,
+			TemplateURLs: &urls,
+		})
+
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		if response.JSONDefault != nil {
+			return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+		}
+	}
+
+	request, err := mapToTemplateApply(data, data.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := applyTemplate(ctx, templatesClient, request); err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return readStack(ctx, data, meta)
+}
+
+func resourceStackDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templatesClient := meta.(*providerMeta).templates
+
+	uninstallResponse, err := templatesClient.UninstallStackWithResponse(ctx, data.Id(), data.Get("org_id").(string))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if uninstallResponse.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(uninstallResponse.JSONDefault, uninstallResponse.StatusCode()))
+	}
+
+	deleteResponse, err := templatesClient.DeleteStackWithResponse(ctx, data.Id(), &domain.DeleteStackParams{OrgID: data.Get("org_id").(string)})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if deleteResponse.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(deleteResponse.JSONDefault, deleteResponse.StatusCode()))
+	}
+
+	return nil
+}
+
+// resourceStackCustomizeDiff runs a dry-run apply and plans an update when InfluxDB reports pending changes. An update
+// of other attributes without pending changes plans dry_run_diff empty. Create and Update keep the planned
+// dry_run_diff, so the state matches the plan.
+func resourceStackCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil || !diff.GetRawConfig().IsWhollyKnown() {
+		return nil
+	}
+
+	templatesClient := meta.(*providerMeta).templates
+
+	request, err := mapToTemplateApply(diff, diff.Id(), true)
+	if err != nil {
+		return err
+	}
+
+	summary, err := applyTemplate(ctx, templatesClient, request)
+	if err != nil {
+		return err
+	}
+
+	changes, err := pendingTemplateChanges(summary)
+	if err != nil {
+		return err
+	}
+
+	// Without pending changes, the plan stays empty unless other attributes change, the next refresh clears the
+	// changes of the last apply.
+	if changes == "" && !diff.HasChanges("name", "description", "urls", "files", "templates", "env_refs") {
+		return nil
+	}
+
+	return diff.SetNew("dry_run_diff", changes)
+}
@@ -0,0 +1,123 @@
+package influxdbv2
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceStack(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_stack" "test" {
+  org_id = local.org_id
+  name   = "tf-acc-stack"
+  templates = [<<-EOT
+    apiVersion: influxdata.com/v2alpha1
+    kind: Bucket
+    metadata:
+      name: tf-acc-stack
+    spec:
+      retentionRules:
+        - type: expire
+          everySeconds: 86400
+  EOT
+  ]
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_stack.test", "resources.#", "1"),
+						resource.TestCheckResourceAttr("influxdbv2_stack.test", "resources.0.kind", "Bucket"),
+						resource.TestMatchResourceAttr("influxdbv2_stack.test", "dry_run_diff", regexp.MustCompile(`"kind":"buckets".*"status":"new"`)),
+					),
+				},
+				{
+					Config: env.config(`
+resource "influxdbv2_stack" "test" {
+  org_id      = local.org_id
+  name        = "tf-acc-stack"
+  description = "updated"
+  templates   = [<<-EOT
+    apiVersion: influxdata.com/v2alpha1
+    kind: Bucket
+    metadata:
+      name: tf-acc-stack
+    spec:
+      retentionRules:
+        - type: expire
+          everySeconds: 86400
+  EOT
+  ]
+}
+`),
+					Check: resource.TestCheckResourceAttr("influxdbv2_stack.test", "dry_run_diff", ""),
+				},
+			},
+		}
+	})
+}
+
+func TestPendingTemplateChanges(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		summary  string
+		expected string
+	}{
+		{"no diff", `{}`, ``},
+		{"empty diff", `{"diff": {"buckets": []}}`, ``},
+		{
+			"unchanged",
+			`{"diff": {"buckets": [{"stateStatus": "exists", "templateMetaName": "metrics", "new": {"name": "metrics"}, "old": {"name": "metrics"}}]}}`,
+			``,
+		},
+		{
+			"new",
+			`{"diff": {"buckets": [{"stateStatus": "new", "templateMetaName": "metrics", "new": {"name": "metrics"}}]}}`,
+			`[{"kind":"buckets","status":"new","template_meta_name":"metrics"}]`,
+		},
+		{
+			"changed",
+			`{"diff": {"buckets": [{"stateStatus": "exists", "templateMetaName": "metrics", "new": {"retentionRules": [{"everySeconds": 3600}]}, "old": {"retentionRules": [{"everySeconds": 86400}]}}]}}`,
+			`[{"kind":"buckets","status":"update","template_meta_name":"metrics"}]`,
+		},
+		{
+			"removed",
+			`{"diff": {"dashboards": [{"stateStatus": "remove", "templateMetaName": "overview", "old": {"name": "Overview"}}]}}`,
+			`[{"kind":"dashboards","status":"remove","template_meta_name":"overview"}]`,
+		},
+		{
+			"label mapping",
+			`{"diff": {"labelMappings": [{"status": "new", "resourceMetaName": "metrics", "labelMetaName": "team"}]}}`,
+			`[{"kind":"labelMappings","label_meta_name":"team","status":"new","template_meta_name":"metrics"}]`,
+		},
+		{
+			"sorted",
+			`{"diff": {"variables": [{"stateStatus": "new", "templateMetaName": "host"}], "buckets": [{"stateStatus": "new", "templateMetaName": "b"}, {"stateStatus": "new", "templateMetaName": "a"}]}}`,
+			`[{"kind":"buckets","status":"new","template_meta_name":"a"},{"kind":"buckets","status":"new","template_meta_name":"b"},{"kind":"variables","status":"new","template_meta_name":"host"}]`,
+		},
+		{
+			"unknown entries",
+			`{"diff": {"buckets": "none", "checks": [{"templateMetaName": "cpu"}, "check"]}}`,
+			``,
+		},
+	} {
+		var summary map[string]interface{}
+		if err := json.Unmarshal([]byte(test.summary), &summary); err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+
+		changes, err := pendingTemplateChanges(summary)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if changes != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, changes)
+		}
+	}
+}
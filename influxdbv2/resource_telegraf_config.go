@@ -0,0 +1,145 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+)
+
+func resourceTelegrafConfig() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB Telegraf configuration resource",
+		CreateContext: resourceTelegrafConfigCreate,
+		ReadContext:   resourceTelegrafConfigRead,
+		UpdateContext: resourceTelegrafConfigUpdate,
+		DeleteContext: resourceTelegrafConfigDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Telegraf configuration name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of the organization that owns the Telegraf configuration.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the Telegraf configuration.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"config": {
+				Description:      "Telegraf configuration in TOML. Whitespace-only changes are ignored.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressTOMLWhitespaceDiff,
+			},
+			"metadata": {
+				Description: "Telegraf configuration metadata.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"buckets": {
+							Description: "Names of the buckets the configuration writes to.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"config_url": {
+				Description: "URL from which Telegraf agents can fetch the configuration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTelegrafConfigCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	telegrafsClient := meta.(*providerMeta).telegrafs
+
+	telegraf := mapToTelegrafRequest(data)
+
+	response, err := telegrafsClient.PostTelegrafsWithResponse(ctx, &domain.PostTelegrafsParams{}, domain.PostTelegrafsJSONRequestBody(*telegraf))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	data.SetId(*response.JSON201.Id)
+
+	return setTelegrafConfigData(data, response.JSON201, meta.(*providerMeta).instance.ServerURL())
+}
+
+func resourceTelegrafConfigRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	telegrafsClient := meta.(*providerMeta).telegrafs
+
+	accept := domain.GetTelegrafsIDParamsAccept("application/json")
+	response, err := telegrafsClient.GetTelegrafsIDWithResponse(ctx, data.Id(), &domain.GetTelegrafsIDParams{Accept: &accept})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setTelegrafConfigData(data, response.JSON200, meta.(*providerMeta).instance.ServerURL())
+}
+
+func resourceTelegrafConfigUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	telegrafsClient := meta.(*providerMeta).telegrafs
+
+	telegraf := mapToTelegrafRequest(data)
+
+	response, err := telegrafsClient.PutTelegrafsIDWithResponse(ctx, data.Id(), &domain.PutTelegrafsIDParams{}, domain.PutTelegrafsIDJSONRequestBody(*telegraf))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setTelegrafConfigData(data, response.JSON200, meta.(*providerMeta).instance.ServerURL())
+}
+
+func resourceTelegrafConfigDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	telegrafsClient := meta.(*providerMeta).telegrafs
+
+	response, err := telegrafsClient.DeleteTelegrafsIDWithResponse(ctx, data.Id(), &domain.DeleteTelegrafsIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccResourceTelegrafConfig(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_telegraf_config" "test" {
+  name        = "tf-acc-telegraf"
+  org_id      = local.org_id
+  description = "cpu metrics"
+  config      = <<-EOT
+    [[inputs.cpu]]
+      percpu = true
+  EOT
+
+  metadata {
+    buckets = ["tf-acc-telegraf"]
+  }
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_telegraf_config.test", "metadata.0.buckets.#", "1"),
+						resource.TestCheckResourceAttrSet("influxdbv2_telegraf_config.test", "config_url"),
+					),
+				},
+				{
+					ResourceName:      "influxdbv2_telegraf_config.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		}
+	})
+}
+
+func TestSuppressTOMLWhitespaceDiff(t *testing.T) {
+	config := "[agent]\n  interval = \"10s\"\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"]\n"
+
+	for _, test := range []struct {
+		name       string
+		new        string
+		suppressed bool
+	}{
+		{"same", config, true},
+		{"indentation", "[agent]\n\tinterval = \"10s\"\n\n[[outputs.influxdb_v2]]\n    urls = [\"http://localhost:8086\"]\n", true},
+		{"trailing whitespace", "[agent]  \n  interval = \"10s\"\t\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"] \n", true},
+		{"blank lines", "\n[agent]\n  interval = \"10s\"\n\n\n\n[[outputs.influxdb_v2]]\n\n  urls = [\"http://localhost:8086\"]\n\n", true},
+		{"windows line endings", "[agent]\r\n  interval = \"10s\"\r\n\r\n[[outputs.influxdb_v2]]\r\n  urls = [\"http://localhost:8086\"]\r\n", true},
+		{"changed value", "[agent]\n  interval = \"20s\"\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"]\n", false},
+		{"whitespace within a line", "[agent]\n  interval  =  \"10s\"\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"]\n", true},
+		{"whitespace around punctuation", "[ agent ]\n  interval=\"10s\"\n\n[[outputs.influxdb_v2]]\n  urls = [ \"http://localhost:8086\" ]\n", true},
+		{"whitespace between words", "[agent]\n  interval = \"10s\"\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"] # default  port\n", false},
+		{"whitespace within a string", "[agent]\n  interval = \"10s \"\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"]\n", false},
+		{"whitespace within a literal string", "[agent]\n  interval = '10s '\n\n[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"]\n", false},
+		{"reordered lines", "[[outputs.influxdb_v2]]\n  urls = [\"http://localhost:8086\"]\n\n[agent]\n  interval = \"10s\"\n", false},
+		{"added line", config + "  organization = \"example\"\n", false},
+		{"empty", "", false},
+	} {
+		if actual := suppressTOMLWhitespaceDiff("config", config, test.new, nil); actual != test.suppressed {
+			t.Errorf("%s: expected %t, got %t", test.name, test.suppressed, actual)
+		}
+	}
+}
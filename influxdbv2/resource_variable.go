@@ -0,0 +1,289 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"strings"
+)
+
+func resourceVariable() *schema.Resource {
+	return &schema.Resource{
+		Description:   "InfluxDB dashboard Variable resource",
+		CreateContext: resourceVariableCreate,
+		ReadContext:   resourceVariableRead,
+		UpdateContext: resourceVariableUpdate,
+		DeleteContext: resourceVariableDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Variable name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "ID of the organization that owns the variable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"query": {
+				Description:  "Values of the variable are the results of a query.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"query", "constant", "map"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Description: "Flux script returning the values.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"language": {
+							Description: "Language of the query.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "flux",
+						},
+					},
+				},
+			},
+			"constant": {
+				Description:  "Values of the variable are a constant list.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"query", "constant", "map"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"values": {
+							Description: "List of values.",
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"map": {
+				Description:  "Values of the variable are the values of a map, selected by their keys.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"query", "constant", "map"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"values": {
+							Description: "Map of keys to values.",
+							Type:        schema.TypeMap,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"selected": {
+				Description: "Selected values of the variable.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"label_ids": {
+				Description: "IDs of the labels attached to the variable.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Description: "Variable creation date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last variable update date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVariableImport,
+		},
+	}
+}
+
+func resourceVariableCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	variablesClient := meta.(*providerMeta).variables
+
+	variable := mapToVariable(data)
+
+	response, err := variablesClient.PostVariablesWithResponse(ctx, &domain.PostVariablesParams{}, domain.PostVariablesJSONRequestBody(*variable))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return variableErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	data.SetId(*response.JSON201.Id)
+
+	diags := updateVariableLabels(ctx, variablesClient, data.Id(), nil, data.Get("label_ids").(*schema.Set).List())
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceVariableRead(ctx, data, meta)
+}
+
+func resourceVariableRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	variablesClient := meta.(*providerMeta).variables
+
+	response, err := variablesClient.GetVariablesIDWithResponse(ctx, data.Id(), &domain.GetVariablesIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSON404 != nil {
+		data.SetId("")
+		return nil
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return setVariableData(data, response.JSON200)
+}
+
+func resourceVariableUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	variablesClient := meta.(*providerMeta).variables
+
+	variable := mapToVariable(data)
+
+	response, err := variablesClient.PutVariablesIDWithResponse(ctx, data.Id(), &domain.PutVariablesIDParams{}, domain.PutVariablesIDJSONRequestBody(*variable))
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return variableErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	if data.HasChange("label_ids") {
+		oldLabels, newLabels := data.GetChange("label_ids")
+		diags := updateVariableLabels(ctx, variablesClient, data.Id(), oldLabels.(*schema.Set).List(), newLabels.(*schema.Set).List())
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceVariableRead(ctx, data, meta)
+}
+
+func resourceVariableDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	variablesClient := meta.(*providerMeta).variables
+
+	response, err := variablesClient.DeleteVariablesIDWithResponse(ctx, data.Id(), &domain.DeleteVariablesIDParams{})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if response.JSONDefault != nil {
+		return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+	}
+
+	return nil
+}
+
+// resourceVariableImport accepts either a variable ID or "<org_id>/<name>".
+func resourceVariableImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	orgId, name, ok := strings.Cut(data.Id(), "/")
+	if !ok {
+		return []*schema.ResourceData{data}, nil
+	}
+
+	variablesClient := meta.(*providerMeta).variables
+
+	response, err := variablesClient.GetVariablesWithResponse(ctx, &domain.GetVariablesParams{OrgID: &orgId})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.JSON400 != nil {
+		return nil, domain.ErrorToHTTPError(response.JSON400, response.StatusCode())
+	}
+
+	if response.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode())
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200.Variables == nil {
+		return nil, fmt.Errorf("no InfluxDB variable named %q in organization %s", name, orgId)
+	}
+
+	for _, variable := range *response.JSON200.Variables {
+		if variable.Name == name {
+			data.SetId(*variable.Id)
+			return []*schema.ResourceData{data}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no InfluxDB variable named %q in organization %s", name, orgId)
+}
+
+// variableErrorDiagnostics points errors of creating or updating a variable at its name if the name is taken.
+func variableErrorDiagnostics(err error) diag.Diagnostics {
+	if apiErr := apiError(err); apiErr != nil && apiErrorCode(apiErr) == "conflict" {
+		return apiErrorAttributeDiagnostics(err, cty.GetAttrPath("name"))
+	}
+
+	return apiErrorDiagnostics(err)
+}
+
+func updateVariableLabels(ctx context.Context, variablesClient variablesAPI, variableId string, oldLabels, newLabels []interface{}) diag.Diagnostics {
+	oldSet := schema.NewSet(schema.HashString, oldLabels)
+	newSet := schema.NewSet(schema.HashString, newLabels)
+
+	for _, labelId := range oldSet.Difference(newSet).List() {
+		response, err := variablesClient.DeleteVariablesIDLabelsIDWithResponse(ctx, variableId, labelId.(string), &domain.DeleteVariablesIDLabelsIDParams{})
+
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		if response.JSONDefault != nil {
+			return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+		}
+	}
+
+	for _, labelId := range newSet.Difference(oldSet).List() {
+		tmp := labelId.(string)
+		response, err := variablesClient.PostVariablesIDLabelsWithResponse(ctx, variableId, &domain.PostVariablesIDLabelsParams{}, domain.PostVariablesIDLabelsJSONRequestBody{LabelID: &tmp})
+
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		if response.JSONDefault != nil {
+			return apiErrorDiagnostics(domain.ErrorToHTTPError(response.JSONDefault, response.StatusCode()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package influxdbv2
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"reflect"
+	"testing"
+)
+
+func TestAccResourceVariable(t *testing.T) {
+	testAccReplay(t, func(env testAccEnv) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: env.config(`
+resource "influxdbv2_variable" "test" {
+  name     = "tf_acc_env"
+  org_id   = local.org_id
+  selected = ["production"]
+  constant {
+    values = ["production", "staging"]
+  }
+}
+`),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("influxdbv2_variable.test", "constant.0.values.#", "2"),
+						resource.TestCheckResourceAttr("influxdbv2_variable.test", "selected.0", "production"),
+					),
+				},
+				{
+					Config: env.config(`
+resource "influxdbv2_variable" "test" {
+  name   = "tf_acc_env"
+  org_id = local.org_id
+  map {
+    values = {
+      "Europe" = "eu-central-1"
+    }
+  }
+}
+`),
+					Check: resource.TestCheckResourceAttr("influxdbv2_variable.test", "map.0.values.Europe", "eu-central-1"),
+				},
+				{
+					ResourceName:      "influxdbv2_variable.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName: "influxdbv2_variable.test",
+					ImportState:  true,
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						return env.orgId + "/tf_acc_env", nil
+					},
+					ImportStateVerify: true,
+				},
+			},
+		}
+	})
+}
+
+func TestMapToVariableSwitchedType(t *testing.T) {
+	resource := resourceVariable()
+
+	created := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":   "env",
+		"org_id": "020f755c3c082000",
+		"constant": []interface{}{
+			map[string]interface{}{"values": []interface{}{"production", "staging"}},
+		},
+	})
+	created.SetId("0a0b0c0d0e0f0000")
+	state := created.State()
+
+	config := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "env",
+		"org_id": "020f755c3c082000",
+		"map": []interface{}{
+			map[string]interface{}{"values": map[string]interface{}{"prod": "production"}},
+		},
+	})
+	diff, err := resource.Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"type":   "map",
+		"values": map[string]interface{}{"prod": "production"},
+	}
+	if arguments := mapToVariable(data).Arguments; !reflect.DeepEqual(arguments, expected) {
+		t.Errorf("expected arguments %v, got %v", expected, arguments)
+	}
+}
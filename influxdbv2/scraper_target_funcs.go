@@ -0,0 +1,39 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func setScraperTargetData(data *schema.ResourceData, scraper *domain.ScraperTargetResponse) diag.Diagnostics {
+	data.Set("org_id", scraper.OrgID)
+	data.Set("name", scraper.Name)
+	data.Set("url", scraper.Url)
+	if scraper.Type != nil {
+		data.Set("type", string(*scraper.Type))
+	}
+	data.Set("bucket_id", scraper.BucketID)
+	data.Set("bucket", scraper.Bucket)
+	data.Set("allow_insecure", scraper.AllowInsecure)
+
+	return nil
+}
+
+func mapToScraperTargetRequest(data *schema.ResourceData) *domain.ScraperTargetRequest {
+	orgId := data.Get("org_id").(string)
+	bucketId := data.Get("bucket_id").(string)
+	name := data.Get("name").(string)
+	url := data.Get("url").(string)
+	scraperType := domain.ScraperTargetRequestType(data.Get("type").(string))
+	allowInsecure := data.Get("allow_insecure").(bool)
+
+	return &domain.ScraperTargetRequest{
+		OrgID:         &orgId,
+		BucketID:      &bucketId,
+		Name:          &name,
+		Url:           &url,
+		Type:          &scraperType,
+		AllowInsecure: &allowInsecure,
+	}
+}
@@ -0,0 +1,263 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"gopkg.in/yaml.v2"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// stackConfig is implemented by both schema.ResourceData and schema.ResourceDiff.
+type stackConfig interface {
+	Get(key string) interface{}
+}
+
+func setStackData(data *schema.ResourceData, stack *domain.Stack) diag.Diagnostics {
+	data.Set("org_id", stack.OrgID)
+
+	if stack.Events == nil || len(*stack.Events) == 0 {
+		return nil
+	}
+
+	// The latest event describes the current state of the stack.
+	events := *stack.Events
+	event := events[len(events)-1]
+
+	data.Set("name", event.Name)
+	data.Set("description", event.Description)
+	if event.Urls != nil {
+		data.Set("urls", *event.Urls)
+	}
+
+	var resources []map[string]interface{}
+	if event.Resources != nil {
+		for _, resource := range *event.Resources {
+			kind := ""
+			if resource.Kind != nil {
+				kind = string(*resource.Kind)
+			}
+			resources = append(resources, map[string]interface{}{
+				"kind":               kind,
+				"resource_id":        resource.ResourceID,
+				"template_meta_name": resource.TemplateMetaName,
+			})
+		}
+	}
+
+	if err := data.Set("resources", resources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// mapToTemplateApply builds the body of a template apply request from the configured template sources.
+func mapToTemplateApply(data stackConfig, stackId string, dryRun bool) (map[string]interface{}, error) {
+	request := map[string]interface{}{
+		"orgID":  data.Get("org_id").(string),
+		"dryRun": dryRun,
+	}
+
+	if stackId != "" {
+		request["stackID"] = stackId
+	}
+
+	var remotes []map[string]interface{}
+	for _, url := range stringList(data.Get("urls").([]interface{})) {
+		remotes = append(remotes, map[string]interface{}{"url": url})
+	}
+	if len(remotes) > 0 {
+		request["remotes"] = remotes
+	}
+
+	var templates []map[string]interface{}
+	for _, file := range stringList(data.Get("files").([]interface{})) {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		objects, err := parseTemplate(contents)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template file %s: %w", file, err)
+		}
+		templates = append(templates, map[string]interface{}{"contents": objects})
+	}
+	for i, template := range stringList(data.Get("templates").([]interface{})) {
+		objects, err := parseTemplate([]byte(template))
+		if err != nil {
+			return nil, fmt.Errorf("invalid template at index %d: %w", i, err)
+		}
+		templates = append(templates, map[string]interface{}{"contents": objects})
+	}
+	if len(templates) > 0 {
+		request["templates"] = templates
+	}
+
+	if envRefs := data.Get("env_refs").(map[string]interface{}); len(envRefs) > 0 {
+		request["envRefs"] = envRefs
+	}
+
+	return request, nil
+}
+
+// parseTemplate decodes the objects of a template in JSON or multi-document YAML.
+func parseTemplate(contents []byte) ([]interface{}, error) {
+	var objects []interface{}
+	decoder := yaml.NewDecoder(bytes.NewReader(contents))
+
+	for {
+		var document interface{}
+		err := decoder.Decode(&document)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch document := normalizeYAML(document).(type) {
+		case nil:
+		case []interface{}:
+			objects = append(objects, document...)
+		default:
+			objects = append(objects, document)
+		}
+	}
+
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("template has no objects")
+	}
+
+	return objects, nil
+}
+
+// normalizeYAML converts the maps decoded from YAML into maps that can be encoded to JSON.
+func normalizeYAML(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		normalized := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			normalized[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return normalized
+	case []interface{}:
+		for i, item := range value {
+			value[i] = normalizeYAML(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// applyTemplate sends the apply request and returns the decoded template summary.
+// The response is decoded generically, as the summary may contain resources the generated types can not represent.
+func applyTemplate(ctx context.Context, templatesClient templatesAPI, request map[string]interface{}) (map[string]interface{}, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := templatesClient.ApplyTemplateWithBody(ctx, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= 300 {
+		var apiError domain.Error
+		if err := json.Unmarshal(responseBody, &apiError); err != nil || apiError.Message == nil {
+			message := strings.TrimSpace(string(responseBody))
+			apiError.Message = &message
+		}
+		return nil, domain.ErrorToHTTPError(&apiError, response.StatusCode)
+	}
+
+	var summary map[string]interface{}
+	if err := json.Unmarshal(responseBody, &summary); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// pendingTemplateChanges lists the changes of the dry-run diff as JSON, or returns an empty string if there are none.
+func pendingTemplateChanges(summary map[string]interface{}) (string, error) {
+	diff, _ := summary["diff"].(map[string]interface{})
+
+	var changes []map[string]interface{}
+	for kind, entries := range diff {
+		entries, _ := entries.([]interface{})
+		for _, entry := range entries {
+			entry, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			status, _ := entry["stateStatus"].(string)
+			if status == "" {
+				status, _ = entry["status"].(string)
+			}
+			if status == "exists" && !reflect.DeepEqual(entry["new"], entry["old"]) {
+				status = "update"
+			}
+			if status == "exists" || status == "" {
+				continue
+			}
+
+			name, _ := entry["templateMetaName"].(string)
+			if name == "" {
+				name, _ = entry["resourceMetaName"].(string)
+			}
+
+			change := map[string]interface{}{
+				"kind":               kind,
+				"template_meta_name": name,
+				"status":             status,
+			}
+			if labelName, ok := entry["labelMetaName"].(string); ok {
+				change["label_meta_name"] = labelName
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	if len(changes) == 0 {
+		return "", nil
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		left, _ := json.Marshal(changes[i])
+		right, _ := json.Marshal(changes[j])
+		return string(left) < string(right)
+	})
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func stringList(values []interface{}) []string {
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		list = append(list, value.(string))
+	}
+	return list
+}
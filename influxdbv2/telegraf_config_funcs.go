@@ -0,0 +1,145 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"strings"
+)
+
+func setTelegrafConfigData(data *schema.ResourceData, telegraf *domain.Telegraf, serverURL string) diag.Diagnostics {
+	data.Set("org_id", telegraf.OrgID)
+	data.Set("name", telegraf.Name)
+	data.Set("description", telegraf.Description)
+	data.Set("config", telegraf.Config)
+	data.Set("config_url", strings.TrimSuffix(serverURL, "/")+"/api/v2/telegrafs/"+*telegraf.Id)
+
+	var metadata []map[string]interface{}
+	if telegraf.Metadata != nil && telegraf.Metadata.Buckets != nil && len(*telegraf.Metadata.Buckets) > 0 {
+		metadata = append(metadata, map[string]interface{}{
+			"buckets": *telegraf.Metadata.Buckets,
+		})
+	}
+
+	data.Set("metadata", metadata)
+
+	return nil
+}
+
+func mapToTelegrafRequest(data *schema.ResourceData) *domain.TelegrafPluginRequest {
+	orgId := data.Get("org_id").(string)
+	name := data.Get("name").(string)
+	config := data.Get("config").(string)
+	description := data.Get("description").(string)
+
+	telegraf := &domain.TelegrafPluginRequest{
+		OrgID:       &orgId,
+		Name:        &name,
+		Config:      &config,
+		Description: &description,
+	}
+
+	var buckets []string
+	for _, metadata := range data.Get("metadata").([]interface{}) {
+		if metadata == nil {
+			continue
+		}
+		for _, bucket := range metadata.(map[string]interface{})["buckets"].([]interface{}) {
+			buckets = append(buckets, bucket.(string))
+		}
+	}
+
+	telegraf.Metadata = &struct {
+		Buckets *[]string `json:"buckets,omitempty"`
+	}{
+		Buckets: &buckets,
+	}
+
+	return telegraf
+}
+
+// suppressTOMLWhitespaceDiff ignores changes of whitespace outside strings, such as indentation, blank lines and
+// the spacing around = and between the values of an array.
+func suppressTOMLWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeTOMLWhitespace(old) == normalizeTOMLWhitespace(new)
+}
+
+// tomlPunctuation separates TOML tokens, so whitespace around it has no meaning.
+const tomlPunctuation = "=,[]{}"
+
+// normalizeTOMLWhitespace drops blank lines and the whitespace around punctuation outside strings and collapses other
+// whitespace outside strings to a single space. Strings, including multi-line strings, and comments are kept as is.
+func normalizeTOMLWhitespace(config string) string {
+	config = strings.ReplaceAll(config, "\r\n", "\n")
+
+	var lines []string
+	var line strings.Builder
+	space := false
+	endLine := func() {
+		if line.Len() > 0 {
+			lines = append(lines, line.String())
+		}
+		line.Reset()
+		space = false
+	}
+
+	for i := 0; i < len(config); i++ {
+		c := config[i]
+		switch {
+		case c == '\n':
+			endLine()
+		case c == ' ' || c == '\t' || c == '\r':
+			space = line.Len() > 0
+		case c == '#':
+			if space {
+				line.WriteByte(' ')
+			}
+			end := strings.IndexByte(config[i:], '\n')
+			if end < 0 {
+				end = len(config) - i
+			}
+			line.WriteString(strings.TrimRight(config[i:i+end], " \t\r"))
+			i += end - 1
+		case strings.IndexByte(tomlPunctuation, c) >= 0:
+			line.WriteByte(c)
+			space = false
+		default:
+			if space && strings.IndexByte(tomlPunctuation, line.String()[line.Len()-1]) < 0 {
+				line.WriteByte(' ')
+			}
+			space = false
+			if c == '"' || c == '\'' {
+				end := tomlStringEnd(config, i)
+				line.WriteString(config[i:end])
+				i = end - 1
+			} else {
+				line.WriteByte(c)
+			}
+		}
+	}
+	endLine()
+
+	return strings.Join(lines, "\n")
+}
+
+// tomlStringEnd returns the index after the string starting at start, which may be a basic or literal string on one
+// line or a multi-line string. Unterminated strings end at the end of the config.
+func tomlStringEnd(config string, start int) int {
+	quote := config[start : start+1]
+	if strings.HasPrefix(config[start:], quote+quote+quote) {
+		quote += quote + quote
+	}
+
+	for i := start + len(quote); i < len(config); i++ {
+		switch {
+		case config[i] == '\\' && quote[0] == '"':
+			i++
+		case config[i] == '\n' && len(quote) == 1:
+			return i
+		case strings.HasPrefix(config[i:], quote):
+			return i + len(quote)
+		}
+	}
+
+	return len(config)
+}
@@ -0,0 +1,100 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+	"strings"
+)
+
+var templateKinds = []string{
+	"Bucket",
+	"Check",
+	"CheckDeadman",
+	"CheckThreshold",
+	"Dashboard",
+	"Label",
+	"NotificationEndpoint",
+	"NotificationEndpointHTTP",
+	"NotificationEndpointPagerDuty",
+	"NotificationEndpointSlack",
+	"NotificationRule",
+	"Task",
+	"Telegraf",
+	"Variable",
+}
+
+func mapToTemplateExport(data *schema.ResourceData) map[string]interface{} {
+	filters := map[string]interface{}{}
+	if kinds := stringList(data.Get("resource_kinds").([]interface{})); len(kinds) > 0 {
+		filters["byResourceKind"] = kinds
+	}
+	if labels := stringList(data.Get("label_names").([]interface{})); len(labels) > 0 {
+		filters["byLabel"] = labels
+	}
+
+	org := map[string]interface{}{
+		"orgID": data.Get("org_id").(string),
+	}
+	if len(filters) > 0 {
+		org["resourceFilters"] = filters
+	}
+
+	return map[string]interface{}{
+		"orgIDs": []interface{}{org},
+	}
+}
+
+// formatTemplate formats the exported template as indented JSON and as multi-document YAML.
+func formatTemplate(body []byte) (string, string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var objects []interface{}
+	if err := decoder.Decode(&objects); err != nil {
+		return "", "", err
+	}
+
+	jsonTemplate, err := json.MarshalIndent(objects, "", "  ")
+	if err != nil {
+		return "", "", err
+	}
+
+	var documents []string
+	for _, object := range objects {
+		document, err := yaml.Marshal(yamlNumbers(object))
+		if err != nil {
+			return "", "", err
+		}
+		documents = append(documents, string(document))
+	}
+
+	return string(jsonTemplate), strings.Join(documents, "---\n"), nil
+}
+
+// yamlNumbers converts JSON numbers to integers or floats, so they are not quoted in YAML.
+func yamlNumbers(value interface{}) interface{} {
+	switch value := value.(type) {
+	case json.Number:
+		if number, err := value.Int64(); err == nil {
+			return number
+		}
+		if number, err := value.Float64(); err == nil {
+			return number
+		}
+		return value.String()
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = yamlNumbers(item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = yamlNumbers(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,103 @@
+package influxdbv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func setVariableData(data *schema.ResourceData, variable *domain.Variable) diag.Diagnostics {
+	data.Set("org_id", variable.OrgID)
+	data.Set("name", variable.Name)
+	data.Set("description", variable.Description)
+	data.Set("selected", variable.Selected)
+	if variable.CreatedAt != nil {
+		data.Set("created_at", variable.CreatedAt.String())
+	}
+	if variable.UpdatedAt != nil {
+		data.Set("updated_at", variable.UpdatedAt.String())
+	}
+
+	var labelIds []string
+	if variable.Labels != nil {
+		for _, label := range *variable.Labels {
+			labelIds = append(labelIds, *label.Id)
+		}
+	}
+	data.Set("label_ids", labelIds)
+
+	arguments, _ := variable.Arguments.(map[string]interface{})
+	values := arguments["values"]
+
+	var query, constant, mapped []map[string]interface{}
+	switch arguments["type"] {
+	case "query":
+		queryValues, _ := values.(map[string]interface{})
+		query = append(query, map[string]interface{}{
+			"query":    queryValues["query"],
+			"language": queryValues["language"],
+		})
+	case "constant":
+		constant = append(constant, map[string]interface{}{
+			"values": values,
+		})
+	case "map":
+		mapped = append(mapped, map[string]interface{}{
+			"values": values,
+		})
+	}
+
+	data.Set("query", query)
+	data.Set("constant", constant)
+	data.Set("map", mapped)
+
+	return nil
+}
+
+func mapToVariable(data *schema.ResourceData) *domain.Variable {
+	variable := &domain.Variable{
+		OrgID: data.Get("org_id").(string),
+		Name:  data.Get("name").(string),
+	}
+
+	description := data.Get("description").(string)
+	variable.Description = &description
+
+	selected := []string{}
+	for _, value := range data.Get("selected").([]interface{}) {
+		selected = append(selected, value.(string))
+	}
+	variable.Selected = &selected
+
+	if query, ok := variableBlock(data, "query"); ok {
+		variable.Arguments = map[string]interface{}{
+			"type": "query",
+			"values": map[string]interface{}{
+				"query":    query["query"],
+				"language": query["language"],
+			},
+		}
+	} else if constant, ok := variableBlock(data, "constant"); ok {
+		variable.Arguments = map[string]interface{}{
+			"type":   "constant",
+			"values": constant["values"],
+		}
+	} else if mapped, ok := variableBlock(data, "map"); ok {
+		variable.Arguments = map[string]interface{}{
+			"type":   "map",
+			"values": mapped["values"],
+		}
+	}
+
+	return variable
+}
+
+// variableBlock returns the configured block of a variable type. The blocks are read as lists, because GetOk of the
+// first element of a block removed by an update still reports its zero value as set.
+func variableBlock(data *schema.ResourceData, key string) (map[string]interface{}, bool) {
+	blocks := data.Get(key).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil, false
+	}
+	return blocks[0].(map[string]interface{}), true
+}
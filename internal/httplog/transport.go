@@ -0,0 +1,115 @@
+// Package httplog logs the HTTP traffic of the provider through tflog, so TF_LOG=DEBUG shows every InfluxDB API call.
+package httplog
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"terraform-provider-influxdbv2/internal/redact"
+	"time"
+)
+
+// maxLoggedBody is the size of the largest body logged. Only this much of a body is buffered, so large writes and
+// query results are streamed as is.
+const maxLoggedBody = 64 << 10
+
+// Response headers holding the ID of the request in InfluxDB logs, in order of preference.
+var requestIdHeaders = []string{"Request-Id", "X-Request-Id", "Trace-Id"}
+
+// Transport is an http.RoundTripper logging requests and responses, with credentials redacted. Every request is
+// logged at DEBUG level with its method, path, status, latency and request ID, and with its bodies at TRACE level.
+// Bodies larger than maxLoggedBody are not logged.
+type Transport struct {
+	transport http.RoundTripper
+}
+
+// Wrap returns a transport logging the traffic of the given transport.
+func Wrap(transport http.RoundTripper) *Transport {
+	return &Transport{transport: transport}
+}
+
+// RoundTrip logs the request and its response through the logger of the request context.
+func (t *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	requestBody, err := peekBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"http_method": request.Method,
+		"http_path":   request.URL.Path,
+	}
+
+	tflog.Trace(ctx, "Sending InfluxDB API request", fields, map[string]interface{}{
+		"http_request_headers": redact.Header(request.Header),
+		"http_request_body":    loggedBody(request.URL.Path, requestBody),
+	})
+
+	start := time.Now()
+	response, err := t.transport.RoundTrip(request)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		tflog.Debug(ctx, "InfluxDB API request failed", fields, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	fields["http_status"] = response.StatusCode
+	for _, header := range requestIdHeaders {
+		if requestId := response.Header.Get(header); requestId != "" {
+			fields["influxdb_request_id"] = requestId
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "Received InfluxDB API response", fields)
+
+	responseBody, err := peekBody(&response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Trace(ctx, "Received InfluxDB API response body", fields, map[string]interface{}{
+		"http_response_body": loggedBody(request.URL.Path, responseBody),
+	})
+
+	return response, nil
+}
+
+// peekBody reads the start of the body, up to one byte more than maxLoggedBody, and puts it back in front of the rest,
+// so the caller still reads the whole body.
+func peekBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	original := *body
+	contents, err := io.ReadAll(io.LimitReader(original, maxLoggedBody+1))
+	if err != nil {
+		original.Close()
+		return nil, err
+	}
+
+	*body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(contents), original), original}
+
+	return contents, nil
+}
+
+// loggedBody redacts the body for the log. Truncated bodies are left out, as credentials can only be redacted from
+// complete JSON documents.
+func loggedBody(path string, contents []byte) string {
+	if len(contents) > maxLoggedBody {
+		return fmt.Sprintf("body larger than %d bytes not logged", maxLoggedBody)
+	}
+
+	return redact.Body(path, contents)
+}
@@ -0,0 +1,138 @@
+package httplog
+
+import (
+	"bytes"
+	"context"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Trace-Id", "0f1e2d3c4b5a6978")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"0a1b2c3d4e5f0001","token":"created-token"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v2/authorizations?orgID=0a1b2c3d4e5f0002", strings.NewReader(`{"orgID":"0a1b2c3d4e5f0002"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Token operator-token")
+
+	client := &http.Client{Transport: Wrap(http.DefaultTransport)}
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	// The caller still reads the whole body.
+	body, _ := io.ReadAll(response.Body)
+	if string(body) != `{"id":"0a1b2c3d4e5f0001","token":"created-token"}` {
+		t.Errorf("unexpected response body %s", body)
+	}
+
+	for _, secret := range []string{"operator-token", "created-token"} {
+		if strings.Contains(output.String(), secret) {
+			t.Errorf("log contains %q:\n%s", secret, output.String())
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var debugEntry map[string]interface{}
+	for _, entry := range entries {
+		if entry["@level"] == "debug" {
+			debugEntry = entry
+		}
+	}
+	if debugEntry == nil {
+		t.Fatalf("no debug entry logged: %v", entries)
+	}
+
+	for field, expected := range map[string]interface{}{
+		"@message":            "Received InfluxDB API response",
+		"http_method":         "POST",
+		"http_path":           "/api/v2/authorizations",
+		"http_status":         float64(201),
+		"influxdb_request_id": "0f1e2d3c4b5a6978",
+	} {
+		if debugEntry[field] != expected {
+			t.Errorf("expected %s to be %v, got %v", field, expected, debugEntry[field])
+		}
+	}
+	if _, ok := debugEntry["latency_ms"]; !ok {
+		t.Error("expected latency_ms to be logged")
+	}
+}
+
+func TestTransportError(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1/api/v2/buckets", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Wrap(http.DefaultTransport).RoundTrip(request); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if !strings.Contains(output.String(), "InfluxDB API request failed") {
+		t.Errorf("expected the failure to be logged:\n%s", output.String())
+	}
+}
+
+func TestTransportLargeBody(t *testing.T) {
+	largeBody := `{"token":"created-token","data":"` + strings.Repeat("x", maxLoggedBody) + `"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != largeBody {
+			t.Errorf("server received a body of %d bytes, expected %d", len(body), len(largeBody))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(largeBody))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v2/authorizations", strings.NewReader(largeBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: Wrap(http.DefaultTransport)}
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	if body, _ := io.ReadAll(response.Body); string(body) != largeBody {
+		t.Errorf("received a body of %d bytes, expected %d", len(body), len(largeBody))
+	}
+
+	if strings.Contains(output.String(), "created-token") {
+		t.Errorf("log contains the token:\n%s", output.String())
+	}
+	if strings.Count(output.String(), "not logged") != 2 {
+		t.Errorf("expected both bodies to be left out:\n%s", output.String())
+	}
+}
@@ -0,0 +1,213 @@
+package influxdbtest
+
+import (
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var resourceTypes = map[domain.ResourceType]bool{
+	domain.ResourceTypeAuthorizations:        true,
+	domain.ResourceTypeBuckets:               true,
+	domain.ResourceTypeChecks:                true,
+	domain.ResourceTypeDashboards:            true,
+	domain.ResourceTypeDbrp:                  true,
+	domain.ResourceTypeDocuments:             true,
+	domain.ResourceTypeLabels:                true,
+	domain.ResourceTypeNotebooks:             true,
+	domain.ResourceTypeNotificationEndpoints: true,
+	domain.ResourceTypeNotificationRules:     true,
+	domain.ResourceTypeOrgs:                  true,
+	domain.ResourceTypeRemotes:               true,
+	domain.ResourceTypeReplications:          true,
+	domain.ResourceTypeScrapers:              true,
+	domain.ResourceTypeSecrets:               true,
+	domain.ResourceTypeSources:               true,
+	domain.ResourceTypeTasks:                 true,
+	domain.ResourceTypeTelegrafs:             true,
+	domain.ResourceTypeUsers:                 true,
+	domain.ResourceTypeVariables:             true,
+	domain.ResourceTypeViews:                 true,
+	domain.ResourceTypeAnnotations:           true,
+}
+
+// Authorization returns a copy of the authorization with the given ID.
+func (s *Server) Authorization(id string) (domain.Authorization, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	authorization, ok := s.authorizations[id]
+	if !ok {
+		return domain.Authorization{}, false
+	}
+	return *authorization, true
+}
+
+// Authorizations returns copies of all authorizations, including the one of the onboarded user.
+func (s *Server) Authorizations() []domain.Authorization {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var authorizations []domain.Authorization
+	for _, id := range sortedIDs(s.authorizations) {
+		authorizations = append(authorizations, *s.authorizations[id])
+	}
+	return authorizations
+}
+
+func (s *Server) serveAuthorizations(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listAuthorizations(w, r)
+		case http.MethodPost:
+			s.createAuthorization(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	authorization, ok := s.authorizations[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "authorization not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, authorization)
+	case http.MethodPatch:
+		s.updateAuthorization(w, r, authorization)
+	case http.MethodDelete:
+		delete(s.authorizations, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) listAuthorizations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	authorizations := []domain.Authorization{}
+	for _, id := range sortedIDs(s.authorizations) {
+		authorization := s.authorizations[id]
+		if userId := query.Get("userID"); userId != "" && *authorization.UserID != userId {
+			continue
+		}
+		if user := query.Get("user"); user != "" && *authorization.User != user {
+			continue
+		}
+		if orgId := query.Get("orgID"); orgId != "" && *authorization.OrgID != orgId {
+			continue
+		}
+		if org := query.Get("org"); org != "" && *authorization.Org != org {
+			continue
+		}
+		authorizations = append(authorizations, *authorization)
+	}
+
+	writeJSON(w, http.StatusOK, domain.Authorizations{Authorizations: &authorizations})
+}
+
+func (s *Server) createAuthorization(w http.ResponseWriter, r *http.Request) {
+	var request domain.AuthorizationPostRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.OrgID == nil || *request.OrgID == "" {
+		writeError(w, http.StatusBadRequest, "invalid", "org ID is required")
+		return
+	}
+	org, ok := s.orgs[*request.OrgID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "organization not found")
+		return
+	}
+
+	if request.Permissions == nil || len(*request.Permissions) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid", "authorization must have at least one permission")
+		return
+	}
+	for _, permission := range *request.Permissions {
+		if permission.Action != domain.PermissionActionRead && permission.Action != domain.PermissionActionWrite {
+			writeError(w, http.StatusBadRequest, "invalid", "unknown action \""+string(permission.Action)+"\"")
+			return
+		}
+		if !resourceTypes[permission.Resource.Type] {
+			writeError(w, http.StatusBadRequest, "invalid", "unknown resource type \""+string(permission.Resource.Type)+"\"")
+			return
+		}
+	}
+
+	userId := s.requestUserID(r)
+	if request.UserID != nil && *request.UserID != "" {
+		userId = *request.UserID
+	}
+	user, ok := s.users[userId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "user not found")
+		return
+	}
+
+	status := domain.AuthorizationUpdateRequestStatusActive
+	if request.Status != nil {
+		status = *request.Status
+	}
+
+	now := time.Now().UTC()
+	permissions := append([]domain.Permission{}, *request.Permissions...)
+	authorization := &domain.Authorization{
+		Id:          s.newID(),
+		Token:       newToken(),
+		OrgID:       org.Id,
+		Org:         &org.Name,
+		UserID:      user.Id,
+		User:        &user.Name,
+		Permissions: &permissions,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	authorization.Status = &status
+	authorization.Description = request.Description
+	s.authorizations[*authorization.Id] = authorization
+
+	writeJSON(w, http.StatusCreated, authorization)
+}
+
+func (s *Server) updateAuthorization(w http.ResponseWriter, r *http.Request, authorization *domain.Authorization) {
+	var request domain.AuthorizationUpdateRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Status != nil {
+		if *request.Status != domain.AuthorizationUpdateRequestStatusActive && *request.Status != domain.AuthorizationUpdateRequestStatusInactive {
+			writeError(w, http.StatusBadRequest, "invalid", "unknown status \""+string(*request.Status)+"\"")
+			return
+		}
+		authorization.Status = request.Status
+	}
+	if request.Description != nil {
+		authorization.Description = request.Description
+	}
+
+	now := time.Now().UTC()
+	authorization.UpdatedAt = &now
+
+	writeJSON(w, http.StatusOK, authorization)
+}
+
+// requestUserID returns the ID of the user owning the token of the request.
+func (s *Server) requestUserID(r *http.Request) string {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Token ")
+	for _, authorization := range s.authorizations {
+		if *authorization.Token == token {
+			return *authorization.UserID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,231 @@
+package influxdbtest
+
+import (
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"time"
+)
+
+// Bucket returns a copy of the bucket with the given ID.
+func (s *Server) Bucket(id string) (domain.Bucket, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	bucket, ok := s.buckets[id]
+	if !ok {
+		return domain.Bucket{}, false
+	}
+	return *bucket, true
+}
+
+func (s *Server) serveBuckets(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listBuckets(w, r)
+		case http.MethodPost:
+			s.createBucket(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	bucket, ok := s.buckets[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "bucket not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, bucket)
+	case http.MethodPatch:
+		s.updateBucket(w, r, bucket)
+	case http.MethodDelete:
+		delete(s.buckets, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	buckets := []domain.Bucket{}
+	for _, id := range sortedIDs(s.buckets) {
+		bucket := s.buckets[id]
+		if bucketId := query.Get("id"); bucketId != "" && *bucket.Id != bucketId {
+			continue
+		}
+		if name := query.Get("name"); name != "" && bucket.Name != name {
+			continue
+		}
+		if orgId := query.Get("orgID"); orgId != "" && *bucket.OrgID != orgId {
+			continue
+		}
+		if orgName := query.Get("org"); orgName != "" {
+			if org := s.orgByName(orgName); org == nil || *org.Id != *bucket.OrgID {
+				continue
+			}
+		}
+		buckets = append(buckets, *bucket)
+	}
+
+	buckets = paginate(r, buckets)
+	writeJSON(w, http.StatusOK, domain.Buckets{Buckets: &buckets})
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	var request domain.PostBucketRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "bucket name is empty")
+		return
+	}
+	if _, ok := s.orgs[request.OrgID]; !ok {
+		writeError(w, http.StatusNotFound, "not found", "organization not found")
+		return
+	}
+	if s.bucketByName(request.OrgID, request.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "conflict", "bucket with name "+request.Name+" already exists")
+		return
+	}
+
+	retentionRules, message := normalizeRetentionRules(request.RetentionRules)
+	if message != "" {
+		writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", message)
+		return
+	}
+
+	now := time.Now().UTC()
+	bucketType := domain.BucketTypeUser
+	orgId := request.OrgID
+	bucket := &domain.Bucket{
+		Id:             s.newID(),
+		Name:           request.Name,
+		Description:    request.Description,
+		OrgID:          &orgId,
+		RetentionRules: retentionRules,
+		Type:           &bucketType,
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+	s.buckets[*bucket.Id] = bucket
+
+	writeJSON(w, http.StatusCreated, bucket)
+}
+
+func (s *Server) updateBucket(w http.ResponseWriter, r *http.Request, bucket *domain.Bucket) {
+	var request domain.PatchBucketRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name != nil && *request.Name != bucket.Name {
+		if *request.Name == "" {
+			writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "bucket name is empty")
+			return
+		}
+		if s.bucketByName(*bucket.OrgID, *request.Name) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "conflict", "bucket with name "+*request.Name+" already exists")
+			return
+		}
+	}
+
+	var retentionRules domain.RetentionRules
+	if request.RetentionRules != nil {
+		for _, rule := range *request.RetentionRules {
+			retentionRule := domain.RetentionRule{
+				Type:                      domain.RetentionRuleTypeExpire,
+				ShardGroupDurationSeconds: rule.ShardGroupDurationSeconds,
+			}
+			if rule.EverySeconds != nil {
+				retentionRule.EverySeconds = *rule.EverySeconds
+			}
+			retentionRules = append(retentionRules, retentionRule)
+		}
+
+		var message string
+		retentionRules, message = normalizeRetentionRules(retentionRules)
+		if message != "" {
+			writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", message)
+			return
+		}
+	}
+
+	if request.Name != nil {
+		bucket.Name = *request.Name
+	}
+	if request.Description != nil {
+		bucket.Description = request.Description
+	}
+	if request.RetentionRules != nil {
+		bucket.RetentionRules = retentionRules
+	}
+
+	now := time.Now().UTC()
+	bucket.UpdatedAt = &now
+
+	writeJSON(w, http.StatusOK, bucket)
+}
+
+func (s *Server) bucketByName(orgId string, name string) *domain.Bucket {
+	for _, bucket := range s.buckets {
+		if *bucket.OrgID == orgId && bucket.Name == name {
+			return bucket
+		}
+	}
+	return nil
+}
+
+// normalizeRetentionRules validates the retention rules and fills in the default shard group duration and the infinite
+// rule of a bucket without rules the way InfluxDB does, returning an error message for invalid rules.
+func normalizeRetentionRules(rules domain.RetentionRules) (domain.RetentionRules, string) {
+	normalized := domain.RetentionRules{}
+
+	for _, rule := range rules {
+		if rule.EverySeconds != 0 && rule.EverySeconds < 3600 {
+			return nil, "expiration seconds must be greater than or equal to one hour"
+		}
+
+		if rule.ShardGroupDurationSeconds == nil {
+			shardGroupDuration := defaultShardGroupDuration(rule.EverySeconds)
+			rule.ShardGroupDurationSeconds = &shardGroupDuration
+		} else if rule.EverySeconds != 0 && *rule.ShardGroupDurationSeconds > rule.EverySeconds {
+			return nil, "shard-group duration must also be less than or equal to retention period"
+		}
+
+		rule.Type = domain.RetentionRuleTypeExpire
+		normalized = append(normalized, rule)
+	}
+
+	// A bucket without rules keeps its data forever, which InfluxDB reports as an infinite rule.
+	if len(normalized) == 0 {
+		shardGroupDuration := defaultShardGroupDuration(0)
+		normalized = append(normalized, domain.RetentionRule{
+			EverySeconds:              0,
+			ShardGroupDurationSeconds: &shardGroupDuration,
+			Type:                      domain.RetentionRuleTypeExpire,
+		})
+	}
+
+	return normalized, ""
+}
+
+func defaultShardGroupDuration(everySeconds int64) int64 {
+	const hour, day = 3600, 24 * 3600
+
+	switch {
+	case everySeconds == 0 || everySeconds >= 180*day:
+		return 7 * day
+	case everySeconds >= 2*day:
+		return day
+	default:
+		return hour
+	}
+}
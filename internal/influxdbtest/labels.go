@@ -0,0 +1,143 @@
+package influxdbtest
+
+import (
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+)
+
+// Label returns a copy of the label with the given ID.
+func (s *Server) Label(id string) (domain.Label, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	label, ok := s.labels[id]
+	if !ok {
+		return domain.Label{}, false
+	}
+	return *label, true
+}
+
+func (s *Server) serveLabels(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listLabels(w, r)
+		case http.MethodPost:
+			s.createLabel(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	label, ok := s.labels[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "label not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, domain.LabelResponse{Label: label})
+	case http.MethodPatch:
+		s.updateLabel(w, r, label)
+	case http.MethodDelete:
+		delete(s.labels, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) listLabels(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	labels := domain.Labels{}
+	for _, id := range sortedIDs(s.labels) {
+		label := s.labels[id]
+		if orgId := query.Get("orgID"); orgId != "" && *label.OrgID != orgId {
+			continue
+		}
+		labels = append(labels, *label)
+	}
+
+	writeJSON(w, http.StatusOK, domain.LabelsResponse{Labels: &labels})
+}
+
+func (s *Server) createLabel(w http.ResponseWriter, r *http.Request) {
+	var request domain.LabelCreateRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "label name is empty")
+		return
+	}
+	if _, ok := s.orgs[request.OrgID]; !ok {
+		writeError(w, http.StatusNotFound, "not found", "organization not found")
+		return
+	}
+	if s.labelByName(request.OrgID, request.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "conflict", "label with name "+request.Name+" already exists")
+		return
+	}
+
+	name := request.Name
+	orgId := request.OrgID
+	label := &domain.Label{
+		Id:         s.newID(),
+		Name:       &name,
+		OrgID:      &orgId,
+		Properties: &domain.Label_Properties{AdditionalProperties: map[string]string{}},
+	}
+	if request.Properties != nil {
+		for key, value := range request.Properties.AdditionalProperties {
+			label.Properties.AdditionalProperties[key] = value
+		}
+	}
+	s.labels[*label.Id] = label
+
+	writeJSON(w, http.StatusCreated, domain.LabelResponse{Label: label})
+}
+
+func (s *Server) updateLabel(w http.ResponseWriter, r *http.Request, label *domain.Label) {
+	var request domain.LabelUpdate
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name != nil && *request.Name != *label.Name {
+		if *request.Name == "" {
+			writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "label name is empty")
+			return
+		}
+		if s.labelByName(*label.OrgID, *request.Name) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "conflict", "label with name "+*request.Name+" already exists")
+			return
+		}
+		label.Name = request.Name
+	}
+
+	// Properties with an empty value are removed.
+	if request.Properties != nil {
+		for key, value := range request.Properties.AdditionalProperties {
+			if value == "" {
+				delete(label.Properties.AdditionalProperties, key)
+			} else {
+				label.Properties.AdditionalProperties[key] = value
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, domain.LabelResponse{Label: label})
+}
+
+func (s *Server) labelByName(orgId string, name string) *domain.Label {
+	for _, label := range s.labels {
+		if *label.OrgID == orgId && *label.Name == name {
+			return label
+		}
+	}
+	return nil
+}
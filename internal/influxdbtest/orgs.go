@@ -0,0 +1,130 @@
+package influxdbtest
+
+import (
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"time"
+)
+
+// Org returns a copy of the organization with the given ID.
+func (s *Server) Org(id string) (domain.Organization, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	org, ok := s.orgs[id]
+	if !ok {
+		return domain.Organization{}, false
+	}
+	return *org, true
+}
+
+func (s *Server) serveOrgs(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listOrgs(w, r)
+		case http.MethodPost:
+			s.createOrg(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	org, ok := s.orgs[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "organization not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, org)
+	case http.MethodPatch:
+		s.updateOrg(w, r, org)
+	case http.MethodDelete:
+		delete(s.orgs, id)
+		for bucketId, bucket := range s.buckets {
+			if *bucket.OrgID == id {
+				delete(s.buckets, bucketId)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) listOrgs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	orgs := []domain.Organization{}
+	for _, id := range sortedIDs(s.orgs) {
+		org := s.orgs[id]
+		if name := query.Get("org"); name != "" && org.Name != name {
+			continue
+		}
+		if orgId := query.Get("orgID"); orgId != "" && *org.Id != orgId {
+			continue
+		}
+		orgs = append(orgs, *org)
+	}
+
+	orgs = paginate(r, orgs)
+	writeJSON(w, http.StatusOK, domain.Organizations{Orgs: &orgs})
+}
+
+func (s *Server) createOrg(w http.ResponseWriter, r *http.Request) {
+	var request domain.PostOrganizationRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "org name is empty")
+		return
+	}
+	if s.orgByName(request.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "conflict", "organization with name "+request.Name+" already exists")
+		return
+	}
+
+	now := time.Now().UTC()
+	org := &domain.Organization{
+		Id:          s.newID(),
+		Name:        request.Name,
+		Description: request.Description,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	s.orgs[*org.Id] = org
+
+	writeJSON(w, http.StatusCreated, org)
+}
+
+func (s *Server) updateOrg(w http.ResponseWriter, r *http.Request, org *domain.Organization) {
+	var request domain.PatchOrganizationRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name != nil && *request.Name != org.Name {
+		if *request.Name == "" {
+			writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "org name is empty")
+			return
+		}
+		if s.orgByName(*request.Name) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "conflict", "organization with name "+*request.Name+" already exists")
+			return
+		}
+		org.Name = *request.Name
+	}
+	if request.Description != nil {
+		org.Description = request.Description
+	}
+
+	now := time.Now().UTC()
+	org.UpdatedAt = &now
+
+	writeJSON(w, http.StatusOK, org)
+}
@@ -0,0 +1,270 @@
+// Package influxdbtest provides a stateful in-memory fake of the InfluxDB v2 API,
+// so the provider can be tested without a running InfluxDB.
+package influxdbtest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Server is a fake InfluxDB OSS server. It is onboarded with an organization, a user and an operator token.
+type Server struct {
+	*httptest.Server
+
+	// Token is the operator token of the onboarded user.
+	Token string
+	// OrgID is the ID of the onboarded organization.
+	OrgID string
+	// UserID is the ID of the onboarded user.
+	UserID string
+	// Build is the flavor reported by the ping endpoint, OSS or Cloud.
+	Build string
+	// Version is reported by the ping and health endpoints. An empty version is not reported.
+	Version string
+
+	lock           sync.Mutex
+	lastID         uint64
+	orgs           map[string]*domain.Organization
+	users          map[string]*domain.UserResponse
+	buckets        map[string]*domain.Bucket
+	authorizations map[string]*domain.Authorization
+	labels         map[string]*domain.Label
+}
+
+// New starts a fake server, which is closed when the test finishes.
+func New(t testing.TB) *Server {
+	s := &Server{
+		Build:          "OSS",
+		Version:        "v2.7.1",
+		lastID:         0x0a1b2c3d4e5f0000,
+		orgs:           map[string]*domain.Organization{},
+		users:          map[string]*domain.UserResponse{},
+		buckets:        map[string]*domain.Bucket{},
+		authorizations: map[string]*domain.Authorization{},
+		labels:         map[string]*domain.Label{},
+	}
+
+	s.onboard()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *Server) onboard() {
+	now := time.Now().UTC()
+
+	user := &domain.UserResponse{Id: s.newID(), Name: "my-user"}
+	userStatus := domain.UserResponseStatusActive
+	user.Status = &userStatus
+	s.users[*user.Id] = user
+
+	org := &domain.Organization{Id: s.newID(), Name: "my-org", CreatedAt: &now, UpdatedAt: &now}
+	s.orgs[*org.Id] = org
+
+	status := domain.AuthorizationUpdateRequestStatusActive
+	description := "my-user's Token"
+	authorization := &domain.Authorization{
+		Id:          s.newID(),
+		Token:       newToken(),
+		OrgID:       org.Id,
+		Org:         &org.Name,
+		UserID:      user.Id,
+		User:        &user.Name,
+		Permissions: &[]domain.Permission{},
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	authorization.Status = &status
+	authorization.Description = &description
+	for _, resourceType := range []domain.ResourceType{domain.ResourceTypeAuthorizations, domain.ResourceTypeBuckets, domain.ResourceTypeOrgs, domain.ResourceTypeUsers, domain.ResourceTypeLabels} {
+		for _, action := range []domain.PermissionAction{domain.PermissionActionRead, domain.PermissionActionWrite} {
+			*authorization.Permissions = append(*authorization.Permissions, domain.Permission{
+				Action:   action,
+				Resource: domain.Resource{Type: resourceType},
+			})
+		}
+	}
+	s.authorizations[*authorization.Id] = authorization
+
+	s.Token = *authorization.Token
+	s.OrgID = *org.Id
+	s.UserID = *user.Id
+}
+
+// newID returns a new 16 character hexadecimal ID, the format InfluxDB uses.
+func (s *Server) newID() *string {
+	s.lastID++
+	id := fmt.Sprintf("%016x", s.lastID)
+	return &id
+}
+
+func newToken() *string {
+	random := make([]byte, 64)
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+	token := base64.URLEncoding.EncodeToString(random)
+	return &token
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.URL.Path {
+	case "/ping":
+		w.Header().Set("X-Influxdb-Build", s.Build)
+		if s.Version != "" {
+			w.Header().Set("X-Influxdb-Version", s.Version)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case "/health":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"name":    "influxdb",
+			"message": "ready for queries and writes",
+			"status":  "pass",
+			"version": s.Version,
+			"commit":  "0000000000",
+		})
+		return
+	case "/ready":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status":  "ready",
+			"started": time.Now().UTC().Add(-time.Minute).Format(time.RFC3339Nano),
+			"up":      "1m0s",
+		})
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/api/v2/") {
+		writeError(w, http.StatusNotFound, "not found", "path not found")
+		return
+	}
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "unauthorized access")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/"), "/")
+	id := ""
+	if len(parts) > 1 {
+		id = parts[1]
+	}
+	if len(parts) > 2 {
+		writeError(w, http.StatusNotFound, "not found", "path not found")
+		return
+	}
+
+	switch parts[0] {
+	case "me":
+		s.getMe(w)
+	case "orgs":
+		s.serveOrgs(w, r, id)
+	case "users":
+		s.serveUsers(w, r, id)
+	case "buckets":
+		s.serveBuckets(w, r, id)
+	case "authorizations":
+		s.serveAuthorizations(w, r, id)
+	case "labels":
+		s.serveLabels(w, r, id)
+	default:
+		writeError(w, http.StatusNotFound, "not found", "path not found")
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Token ")
+	for _, authorization := range s.authorizations {
+		if *authorization.Token == token && *authorization.Status == domain.AuthorizationUpdateRequestStatusActive {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]string{
+		"code":    code,
+		"message": message,
+	})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "method not allowed", "method not allowed")
+}
+
+// decodeBody decodes the JSON request body, writing a 400 error if it is invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid", "failed to decode request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// sortedIDs returns the keys of a collection in creation order.
+func sortedIDs[T any](collection map[string]T) []string {
+	ids := make([]string, 0, len(collection))
+	for id := range collection {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// paginate applies the offset and limit query parameters to a list.
+func paginate[T any](r *http.Request, list []T) []T {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+
+	if offset >= len(list) {
+		return []T{}
+	}
+	list = list[offset:]
+	if limit < len(list) {
+		list = list[:limit]
+	}
+	return list
+}
+
+func (s *Server) orgByName(name string) *domain.Organization {
+	for _, org := range s.orgs {
+		if org.Name == name {
+			return org
+		}
+	}
+	return nil
+}
+
+func (s *Server) userByName(name string) *domain.UserResponse {
+	for _, user := range s.users {
+		if user.Name == name {
+			return user
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package influxdbtest
+
+import (
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"net/http"
+)
+
+// User returns a copy of the user with the given ID.
+func (s *Server) User(id string) (domain.UserResponse, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return domain.UserResponse{}, false
+	}
+	return *user, true
+}
+
+func (s *Server) getMe(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, s.users[s.UserID])
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listUsers(w, r)
+		case http.MethodPost:
+			s.createUser(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	user, ok := s.users[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found", "user not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, user)
+	case http.MethodPatch:
+		s.updateUser(w, r, user)
+	case http.MethodDelete:
+		delete(s.users, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	users := []domain.UserResponse{}
+	for _, id := range sortedIDs(s.users) {
+		user := s.users[id]
+		if name := query.Get("name"); name != "" && user.Name != name {
+			continue
+		}
+		if userId := query.Get("id"); userId != "" && *user.Id != userId {
+			continue
+		}
+		users = append(users, *user)
+	}
+
+	users = paginate(r, users)
+	writeJSON(w, http.StatusOK, domain.Users{Users: &users})
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var request domain.User
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "unprocessable entity", "user name is empty")
+		return
+	}
+	if s.userByName(request.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "conflict", "user with name "+request.Name+" already exists")
+		return
+	}
+
+	status := domain.UserResponseStatusActive
+	if request.Status != nil {
+		status = domain.UserResponseStatus(*request.Status)
+	}
+
+	user := &domain.UserResponse{
+		Id:     s.newID(),
+		Name:   request.Name,
+		Status: &status,
+	}
+	s.users[*user.Id] = user
+
+	writeJSON(w, http.StatusCreated, user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, user *domain.UserResponse) {
+	var request domain.User
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	if request.Name != "" && request.Name != user.Name {
+		if s.userByName(request.Name) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "conflict", "user with name "+request.Name+" already exists")
+			return
+		}
+		user.Name = request.Name
+	}
+	if request.Status != nil {
+		status := domain.UserResponseStatus(*request.Status)
+		user.Status = &status
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
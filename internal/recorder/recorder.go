@@ -0,0 +1,300 @@
+// Package recorder records the HTTP traffic of the provider to cassettes and replays it,
+// so acceptance tests captured once against a real InfluxDB can run without one.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"terraform-provider-influxdbv2/internal/redact"
+)
+
+// Environment variables used to inject the recorder into the provider.
+const (
+	// CassetteEnv is the path of the cassette to replay or record.
+	CassetteEnv = "INFLUXDBV2_CASSETTE"
+	// RecordEnv switches from replaying to recording when set to a non-empty value.
+	RecordEnv = "INFLUXDBV2_RECORD"
+)
+
+// Response headers kept in cassettes.
+var recordedHeaders = []string{"Content-Type", "X-Influxdb-Build", "X-Influxdb-Version"}
+
+// Cassette is the recorded traffic of one test.
+type Cassette struct {
+	// Variables are values of the recording environment the test configuration depends on, such as the organization ID.
+	Variables    map[string]string `json:"variables,omitempty"`
+	Interactions []*Interaction    `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording to or replaying from a cassette.
+type Recorder struct {
+	path      string
+	recording bool
+	transport http.RoundTripper
+
+	lock     sync.Mutex
+	cassette Cassette
+	replayed map[*Interaction]bool
+}
+
+var (
+	recordersLock sync.Mutex
+	recorders     = map[string]*Recorder{}
+)
+
+// Open returns the recorder of the cassette. Every provider instance configured in the process shares the recorder,
+// so a test replays its cassette in order across all Terraform commands it runs.
+// A recording recorder starts with an empty cassette and a replaying one fails if the cassette does not exist.
+func Open(path string, recording bool) (*Recorder, error) {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	if recorder, ok := recorders[path]; ok {
+		if recorder.recording != recording {
+			return nil, fmt.Errorf("cassette %s is already open in another mode", path)
+		}
+		return recorder, nil
+	}
+
+	recorder := &Recorder{
+		path:      path,
+		recording: recording,
+		transport: http.DefaultTransport,
+		cassette:  Cassette{Variables: map[string]string{}, Interactions: []*Interaction{}},
+		replayed:  map[*Interaction]bool{},
+	}
+
+	if recording {
+		if err := recorder.save(); err != nil {
+			return nil, err
+		}
+	} else {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(contents, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+		}
+	}
+
+	recorders[path] = recorder
+
+	return recorder, nil
+}
+
+// FromEnv opens the recorder configured through CassetteEnv and RecordEnv, or returns nil if no cassette is set.
+func FromEnv() (*Recorder, error) {
+	path := os.Getenv(CassetteEnv)
+	if path == "" {
+		return nil, nil
+	}
+
+	return Open(path, os.Getenv(RecordEnv) != "")
+}
+
+// Close forgets the recorder of the cassette, so the next Open starts over.
+func Close(path string) {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	delete(recorders, path)
+}
+
+// Wrap sets the transport recorded requests are sent through.
+func (r *Recorder) Wrap(transport http.RoundTripper) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.transport = transport
+}
+
+// Recording reports whether the recorder records new interactions.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Variable returns a variable stored in the cassette.
+func (r *Recorder) Variable(name string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.cassette.Variables[name]
+}
+
+// SetVariable stores a variable in the cassette being recorded.
+func (r *Recorder) SetVariable(name string, value string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Variables[name] = value
+	return r.save()
+}
+
+// RoundTrip records the request and its response, or replays the response recorded for the request.
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	recordedRequest := Request{
+		Method: request.Method,
+		URL:    request.URL.RequestURI(),
+		Body:   redact.Body(request.URL.Path, body),
+	}
+
+	if r.recording {
+		return r.record(request, recordedRequest)
+	}
+
+	return r.replay(request, recordedRequest)
+}
+
+func (r *Recorder) record(request *http.Request, recordedRequest Request) (*http.Response, error) {
+	r.lock.Lock()
+	transport := r.transport
+	r.lock.Unlock()
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	recordedResponse := Response{
+		Status:  response.StatusCode,
+		Headers: map[string]string{},
+		Body:    redact.Body(request.URL.Path, body),
+	}
+	for _, header := range recordedHeaders {
+		if value := response.Header.Get(header); value != "" {
+			recordedResponse.Headers[header] = value
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request:  recordedRequest,
+		Response: recordedResponse,
+	})
+
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// replay returns the first unused interaction matching the request. Once all matching interactions are used,
+// the last one is replayed again, as repeated reads of an unchanged resource return the same response.
+func (r *Recorder) replay(request *http.Request, recordedRequest Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var match *Interaction
+	for _, interaction := range r.cassette.Interactions {
+		if !sameRequest(interaction.Request, recordedRequest) {
+			continue
+		}
+		match = interaction
+		if !r.replayed[interaction] {
+			break
+		}
+	}
+
+	if match == nil {
+		return nil, fmt.Errorf("cassette %s has no interaction for %s %s", r.path, recordedRequest.Method, recordedRequest.URL)
+	}
+	r.replayed[match] = true
+
+	header := http.Header{}
+	for name, value := range match.Response.Headers {
+		header.Set(name, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.Status, http.StatusText(match.Response.Status)),
+		StatusCode:    match.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Request:       request,
+	}, nil
+}
+
+func (r *Recorder) save() error {
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, append(contents, '\n'), 0644)
+}
+
+func sameRequest(recorded Request, request Request) bool {
+	return recorded.Method == request.Method && recorded.URL == request.URL && equivalentBody(recorded.Body, request.Body)
+}
+
+// equivalentBody compares JSON bodies semantically and other bodies exactly.
+func equivalentBody(recorded string, body string) bool {
+	if recorded == body {
+		return true
+	}
+
+	var recordedValue, value interface{}
+	if json.Unmarshal([]byte(recorded), &recordedValue) != nil || json.Unmarshal([]byte(body), &value) != nil {
+		return false
+	}
+
+	recordedJSON, _ := json.Marshal(recordedValue)
+	valueJSON, _ := json.Marshal(value)
+
+	return bytes.Equal(recordedJSON, valueJSON)
+}
@@ -0,0 +1,125 @@
+package recorder
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Influxdb-Version", "v2.7.1")
+		w.Header().Set("Date", "Mon, 01 Jan 2024 00:00:00 GMT")
+		switch r.URL.Path {
+		case "/api/v2/authorizations":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"0a1b2c3d4e5f0001","token":"secret-token","status":"active"}`))
+		case "/api/v2/orgs/0a1b2c3d4e5f0002/secrets":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Write([]byte(`{"call":` + string(rune('0'+calls)) + `}`))
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := Open(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.SetVariable("org_id", "0a1b2c3d4e5f0002"); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: recorder}
+	requests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPost, "/api/v2/authorizations", `{"orgID":"0a1b2c3d4e5f0002","permissions":[]}`},
+		{http.MethodPatch, "/api/v2/orgs/0a1b2c3d4e5f0002/secrets", `{"password":"hunter2"}`},
+		{http.MethodGet, "/api/v2/buckets?name=metrics", ""},
+		{http.MethodGet, "/api/v2/buckets?name=metrics", ""},
+	}
+
+	var recorded []string
+	for _, r := range requests {
+		recorded = append(recorded, doRequest(t, client, server.URL, r.method, r.path, r.body))
+	}
+	Close(path)
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-token", "hunter2", "Date"} {
+		if strings.Contains(string(contents), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, contents)
+		}
+	}
+
+	server.Close()
+
+	recorder, err = Open(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Close(path)
+
+	if orgId := recorder.Variable("org_id"); orgId != "0a1b2c3d4e5f0002" {
+		t.Errorf("expected org_id variable, got %q", orgId)
+	}
+
+	client = &http.Client{Transport: recorder}
+	for i, r := range requests {
+		replayed := doRequest(t, client, "http://influxdb.invalid", r.method, r.path, r.body)
+		expected := recorded[i]
+		if i == 0 {
+			expected = `201 Created v2.7.1 {"id":"0a1b2c3d4e5f0001","status":"active","token":"REDACTED"}`
+		}
+		if replayed != expected {
+			t.Errorf("request %d: expected %q, got %q", i, expected, replayed)
+		}
+	}
+
+	// Requests beyond the recording get the last matching response.
+	if replayed := doRequest(t, client, "http://influxdb.invalid", http.MethodGet, "/api/v2/buckets?name=metrics", ""); replayed != recorded[3] {
+		t.Errorf("expected %q, got %q", recorded[3], replayed)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "http://influxdb.invalid/api/v2/buckets?name=other", nil)
+	if _, err := client.Do(request); err == nil || !strings.Contains(err.Error(), "no interaction") {
+		t.Errorf("expected missing interaction error, got %v", err)
+	}
+}
+
+func doRequest(t *testing.T, client *http.Client, host string, method string, path string, body string) string {
+	t.Helper()
+
+	request, err := http.NewRequest(method, host+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Token operator-token")
+
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.Status + " " + response.Header.Get("X-Influxdb-Version") + " " + string(responseBody)
+}
@@ -0,0 +1,96 @@
+// Package redact removes credentials from the HTTP traffic of the provider before it is recorded or logged.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Placeholder replaces redacted values.
+const Placeholder = "REDACTED"
+
+// Names of JSON fields holding credentials, compared case-insensitively.
+var sensitiveFields = map[string]bool{
+	"token":          true,
+	"remoteapitoken": true,
+	"password":       true,
+}
+
+// Assignments of credentials in TOML, e.g. token = "..." in a Telegraf configuration.
+var tomlCredentials = regexp.MustCompile(`(?im)^(\s*[a-z0-9_]*(?:token|password)\s*=\s*)("(?:[^"\\\n]|\\.)*"|'[^'\n]*')`)
+
+// Headers holding credentials.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// Body replaces credentials in JSON bodies. All values of organization secrets are credentials, and so are the values of
+// token and password assignments in Telegraf configurations, which are also sent as plain TOML.
+func Body(path string, body []byte) string {
+	telegraf := strings.HasPrefix(path, "/api/v2/telegrafs")
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.Decode(new(interface{})) != io.EOF {
+		if telegraf {
+			return redactTOML(string(body))
+		}
+		return string(body)
+	}
+
+	redactAll := strings.HasSuffix(path, "/secrets")
+	redactValue(value, redactAll, telegraf)
+
+	redactedBody, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redactedBody)
+}
+
+func redactValue(value interface{}, redactAll bool, redactConfig bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			text, ok := item.(string)
+			if ok && (redactAll || sensitiveFields[strings.ToLower(key)]) {
+				value[key] = Placeholder
+				continue
+			}
+			if ok && redactConfig && key == "config" {
+				value[key] = redactTOML(text)
+				continue
+			}
+			redactValue(item, redactAll, redactConfig)
+		}
+	case []interface{}:
+		for _, item := range value {
+			redactValue(item, redactAll, redactConfig)
+		}
+	}
+}
+
+// redactTOML replaces the values of token and password assignments in a TOML document.
+func redactTOML(document string) string {
+	return tomlCredentials.ReplaceAllString(document, `${1}"`+Placeholder+`"`)
+}
+
+// Header returns the first value of every header, with credentials replaced.
+func Header(header http.Header) map[string]string {
+	values := map[string]string{}
+	for name := range header {
+		values[name] = header.Get(name)
+	}
+
+	for _, name := range sensitiveHeaders {
+		if _, ok := values[name]; ok {
+			values[name] = Placeholder
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,44 @@
+package redact
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		path     string
+		body     string
+		expected string
+	}{
+		{"/api/v2/authorizations", `{"token":"abc","description":"x"}`, `{"description":"x","token":"REDACTED"}`},
+		{"/api/v2/remotes", `{"remoteAPIToken":"abc","name":"cloud"}`, `{"name":"cloud","remoteAPIToken":"REDACTED"}`},
+		{"/api/v2/orgs/1/secrets", `{"a":"1","b":"2"}`, `{"a":"REDACTED","b":"REDACTED"}`},
+		{"/api/v2/write", "cpu value=1 1", "cpu value=1 1"},
+		{
+			"/api/v2/telegrafs",
+			`{"name":"cpu","config":"[[outputs.influxdb_v2]]\n  token = \"abc\"\n  organization = \"example\"\n[[inputs.mqtt_consumer]]\n  password='abc'\n  sasl_password = \"a\\\"bc\"\n"}`,
+			`{"config":"[[outputs.influxdb_v2]]\n  token = \"REDACTED\"\n  organization = \"example\"\n[[inputs.mqtt_consumer]]\n  password=\"REDACTED\"\n  sasl_password = \"REDACTED\"\n","name":"cpu"}`,
+		},
+		{"/api/v2/telegrafs/1", "[agent]\n  interval = \"10s\"\n[[outputs.influxdb_v2]]\n  Token = \"abc\"\n", "[agent]\n  interval = \"10s\"\n[[outputs.influxdb_v2]]\n  Token = \"REDACTED\"\n"},
+		{"/api/v2/dashboards", `{"config":"token = \"abc\""}`, `{"config":"token = \"abc\""}`},
+	}
+
+	for _, test := range tests {
+		if redactedBody := Body(test.path, []byte(test.body)); redactedBody != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.path, test.expected, redactedBody)
+		}
+	}
+}
+
+func TestHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Token secret-token")
+	header.Set("Content-Type", "application/json")
+
+	expected := map[string]string{"Authorization": Placeholder, "Content-Type": "application/json"}
+	if actual := Header(header); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"terraform-provider-influxdbv2/influxdbv2"
 )
 
@@ -32,14 +34,18 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{
-		Debug: debugMode,
-
-		// TODO: update this string with the full name of your provider as used in your configs
-		ProviderAddr: "registry.terraform.io/matrasas/influxdbv2",
+	providerServer, err := influxdbv2.NewMuxServer(context.Background(), version)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		ProviderFunc: influxdbv2.New(version),
+	var serveOpts []tf6server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
-	plugin.Serve(opts)
+	err = tf6server.Serve("registry.terraform.io/matrasas/influxdbv2", providerServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }